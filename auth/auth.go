@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/golang-jwt/jwt"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// HS256 is a JWT signing algorithm using HMAC-SHA256 with a shared secret
+	HS256 = "HS256"
+
+	// RS256 is a JWT signing algorithm using RSA-SHA256 with a public key
+	RS256 = "RS256"
+)
+
+var (
+	// ErrUnauthorized is returned when a token cannot be authenticated
+	ErrUnauthorized = errors.New("auth: unauthorized")
+)
+
+// Principal represents an authenticated consumer and its permissions
+type Principal struct {
+	// Subject identifies the consumer, e.g. a tenant name
+	Subject string
+
+	// Events are allowed event types, all events are allowed if it is empty
+	Events []string
+
+	// MaxSubscriptions is a maximum number of subscribed events per connection, zero means unlimited
+	MaxSubscriptions int
+}
+
+// Allows returns true if the principal may subscribe the event type
+func (p *Principal) Allows(eventType string) bool {
+	if p == nil || len(p.Events) == 0 {
+		return true
+	}
+
+	for _, e := range p.Events {
+		if e == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Config represents an authenticator configuration
+type Config struct {
+	// APIKeysFile is a path to a yaml file of static API keys
+	APIKeysFile string
+
+	// JWTAlgorithm is either HS256 or RS256, JWT is disabled if it is empty
+	JWTAlgorithm string
+
+	// JWTSecret is a shared secret for HS256
+	JWTSecret string
+
+	// JWTPublicKeyFile is a path to a PEM encoded public key for RS256
+	JWTPublicKeyFile string
+}
+
+// Authenticator authenticates API keys and JWTs
+type Authenticator struct {
+	mu      sync.RWMutex
+	keys    map[string]*Principal
+	alg     string
+	keyFunc jwt.Keyfunc
+}
+
+type apiKeysFile struct {
+	Keys []struct {
+		Key              string   `yaml:"key"`
+		Subject          string   `yaml:"subject"`
+		Events           []string `yaml:"events"`
+		MaxSubscriptions int      `yaml:"max_subscriptions"`
+	} `yaml:"keys"`
+}
+
+type claims struct {
+	jwt.StandardClaims
+	Events           []string `json:"events,omitempty"`
+	MaxSubscriptions int      `json:"max_subscriptions,omitempty"`
+}
+
+// New creates a new authenticator
+func New(c Config) (*Authenticator, error) {
+	a := &Authenticator{
+		keys: make(map[string]*Principal),
+		alg:  c.JWTAlgorithm,
+	}
+
+	if c.APIKeysFile != "" {
+		keys, err := loadAPIKeys(c.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+
+		a.keys = keys
+	}
+
+	switch c.JWTAlgorithm {
+	case "":
+	case HS256:
+		if c.JWTSecret == "" {
+			return nil, fmt.Errorf("auth: JWTSecret is required for HS256")
+		}
+
+		secret := []byte(c.JWTSecret)
+		a.keyFunc = func(t *jwt.Token) (interface{}, error) {
+			return secret, nil
+		}
+	case RS256:
+		bb, err := os.ReadFile(c.JWTPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := jwt.ParseRSAPublicKeyFromPEM(bb)
+		if err != nil {
+			return nil, err
+		}
+
+		a.keyFunc = func(t *jwt.Token) (interface{}, error) {
+			return key, nil
+		}
+	default:
+		return nil, fmt.Errorf("auth: unsupported JWT algorithm %s", c.JWTAlgorithm)
+	}
+
+	if len(a.keys) == 0 && a.keyFunc == nil {
+		return nil, fmt.Errorf("auth: either APIKeysFile or JWTAlgorithm must be set")
+	}
+
+	return a, nil
+}
+
+// Reload replaces API keys and JWT settings, the current ones are kept if the new configuration is invalid
+func (a *Authenticator) Reload(c Config) error {
+	next, err := New(c)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.keys = next.keys
+	a.alg = next.alg
+	a.keyFunc = next.keyFunc
+
+	return nil
+}
+
+// Authenticate returns a principal of an API key or a JWT
+func (a *Authenticator) Authenticate(token string) (*Principal, error) {
+	if token == "" {
+		return nil, ErrUnauthorized
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if p, ok := a.keys[token]; ok {
+		return p, nil
+	}
+
+	if a.keyFunc == nil {
+		return nil, ErrUnauthorized
+	}
+
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		if t.Method.Alg() != a.alg {
+			return nil, fmt.Errorf("auth: unexpected signing method %s", t.Method.Alg())
+		}
+
+		return a.keyFunc(t)
+	})
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	return &Principal{
+		Subject:          c.Subject,
+		Events:           c.Events,
+		MaxSubscriptions: c.MaxSubscriptions,
+	}, nil
+}
+
+func loadAPIKeys(path string) (map[string]*Principal, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f apiKeysFile
+	if err := yaml.Unmarshal(bb, &f); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*Principal)
+	for _, k := range f.Keys {
+		if k.Key == "" {
+			return nil, fmt.Errorf("auth: API key of %s is empty", k.Subject)
+		}
+
+		keys[k.Key] = &Principal{
+			Subject:          k.Subject,
+			Events:           k.Events,
+			MaxSubscriptions: k.MaxSubscriptions,
+		}
+	}
+
+	return keys, nil
+}
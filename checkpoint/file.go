@@ -0,0 +1,60 @@
+package checkpoint
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// File persists the last processed round in a file
+type File struct {
+	path string
+}
+
+// NewFile creates a new file checkpoint
+func NewFile(path string) *File {
+	return &File{
+		path: path,
+	}
+}
+
+// Load returns the saved round, it returns false if nothing was saved yet
+func (f *File) Load() (uint64, bool, error) {
+	bb, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	round, err := strconv.ParseUint(strings.TrimSpace(string(bb)), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return round, true, nil
+}
+
+// Save saves a round, the file is replaced atomically so a crash never leaves a partial checkpoint
+func (f *File) Save(round uint64) error {
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(strconv.FormatUint(round, 10) + "\n"); err != nil {
+		_ = tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.path)
+}
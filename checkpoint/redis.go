@@ -0,0 +1,110 @@
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrFenced is returned when a fenced checkpoint is saved by an instance that is no longer the leader
+	ErrFenced = errors.New("checkpoint: fencing token is stale")
+
+	// fencedSaveScript saves a round only if the fencing token is still the latest one
+	fencedSaveScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("SET", KEYS[2], ARGV[2])
+end
+return false
+`)
+)
+
+// RedisConfig represents a configuration for Redis checkpoint
+type RedisConfig struct {
+	RedisHost     string
+	RedisPassword string
+	Key           string
+	// FenceKey holds the latest fencing token, it is only used by a checkpoint returned by Fenced
+	FenceKey string
+	Timeout  time.Duration
+}
+
+// Redis persists the last processed round in Redis, so it is shared by every monitor instance
+type Redis struct {
+	conf  RedisConfig
+	rdb   *redis.Client
+	token uint64
+}
+
+// NewRedis creates a new Redis checkpoint
+func NewRedis(conf RedisConfig) (*Redis, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     conf.RedisHost,
+		Password: conf.RedisPassword,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(10)*time.Second)
+	defer cancel()
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+
+	log.Info().Msg("checkpoint: connected to Redis")
+
+	return &Redis{
+		conf: conf,
+		rdb:  rdb,
+	}, nil
+}
+
+// Fenced returns a checkpoint sharing the connection that rejects saves once the token is not the latest one
+func (r *Redis) Fenced(token uint64) *Redis {
+	return &Redis{
+		conf:  r.conf,
+		rdb:   r.rdb,
+		token: token,
+	}
+}
+
+// Close closes the Redis connection
+func (r *Redis) Close() error {
+	return r.rdb.Close()
+}
+
+// Load returns the saved round, it returns false if nothing was saved yet
+func (r *Redis) Load() (uint64, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.conf.Timeout)
+	defer cancel()
+
+	round, err := r.rdb.Get(ctx, r.conf.Key).Uint64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return round, true, nil
+}
+
+// Save saves a round
+func (r *Redis) Save(round uint64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.conf.Timeout)
+	defer cancel()
+
+	if r.token == 0 {
+		return r.rdb.Set(ctx, r.conf.Key, round, 0).Err()
+	}
+
+	err := fencedSaveScript.Run(ctx, r.rdb, []string{r.conf.FenceKey, r.conf.Key}, strconv.FormatUint(r.token, 10), round).Err()
+	if err == redis.Nil {
+		return ErrFenced
+	}
+
+	return err
+}
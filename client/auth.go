@@ -0,0 +1,25 @@
+package client
+
+import (
+	"fmt"
+)
+
+func (c *Client) authenticate(req Request) error {
+	if req.Method != methodAuth {
+		return fmt.Errorf("authentication required")
+	}
+
+	if len(req.Params) != 1 {
+		return fmt.Errorf("token is required")
+	}
+
+	principal, err := c.conf.Authenticator.Authenticate(req.Params[0])
+	if err != nil {
+		return fmt.Errorf("unauthorized")
+	}
+
+	c.principal = principal
+	c.authenticated.Store(true)
+
+	return nil
+}
@@ -10,14 +10,22 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"go.uber.org/atomic"
+	"golang.org/x/time/rate"
 
+	"github.com/synycboom/algorand-notification/auth"
 	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/metrics"
+	"github.com/synycboom/algorand-notification/quota"
 )
 
 const (
-	invalidFormat     = 400
-	methodSubscribe   = "SUBSCRIBE"
-	methodUnsubscribe = "UNSUBSCRIBE"
+	// CloseUnauthorized is a close code sent when a connection fails to authenticate
+	CloseUnauthorized = 4401
+
+	methodAuth              = "AUTH"
+	methodSubscribe         = "SUBSCRIBE"
+	methodUnsubscribe       = "UNSUBSCRIBE"
+	methodListSubscriptions = "LIST_SUBSCRIPTIONS"
 )
 
 var (
@@ -36,6 +44,10 @@ type Request struct {
 	ID     uint64   `json:"id"`
 	Method string   `json:"method"`
 	Params []string `json:"params"`
+	// Fields optionally selects fields of the data of subscribed events
+	Fields []string `json:"fields,omitempty"`
+	// Batch sends subscribed events of a round in one message
+	Batch bool `json:"batch,omitempty"`
 }
 
 // Response represents a websocket response payload
@@ -48,6 +60,7 @@ type Response struct {
 // ResponseError is a response error
 type ResponseError struct {
 	Code    int    `json:"code"`
+	Reason  string `json:"reason,omitempty"`
 	Message string `json:"message"`
 }
 
@@ -59,9 +72,51 @@ type GorillaConnection interface {
 	ReadMessage() (messageType int, p []byte, err error)
 	SetWriteDeadline(t time.Time) error
 	WriteMessage(messageType int, data []byte) error
+	EnableWriteCompression(enable bool)
+	SetCompressionLevel(level int) error
 	Close() error
 }
 
+// Authenticator represents a contract for authenticating tokens sent with the AUTH method
+type Authenticator interface {
+	// Authenticate returns a principal of a token
+	Authenticate(token string) (*auth.Principal, error)
+}
+
+// QuotaProvider represents a contract for current quotas
+type QuotaProvider interface {
+	// Config returns the current quota configuration
+	Config() quota.Config
+}
+
+// Session represents information of an upgraded connection
+type Session struct {
+	// Principal is nil if the connection has not been authenticated yet
+	Principal *auth.Principal
+
+	// Release is called once the connection is closed
+	Release func()
+
+	// Format is a negotiated wire format of events, it is json if empty
+	Format string
+
+	// Compression is true if permessage-deflate was negotiated
+	Compression bool
+
+	// Version is a negotiated protocol version of events, it is the version 1 if zero
+	Version int
+
+	// JSONRPC is true if the connection speaks JSON-RPC 2.0, events must be sent in the json format
+	JSONRPC bool
+}
+
+// frame is a websocket message queued for writing
+type frame struct {
+	messageType int
+	data        []byte
+	compress    bool
+}
+
 // Config represents a factory configuration
 type Config struct {
 	WriteWaitTimeout   time.Duration
@@ -69,6 +124,30 @@ type Config struct {
 	PingInterval       time.Duration
 	MaxReadMessageSize int64
 	SendBufferSize     int
+
+	// CompressionLevel is a flate level of events sent to connections that negotiated permessage-deflate
+	CompressionLevel int
+
+	// CompressionMinSize is a minimum size of an encoded event to be compressed
+	CompressionMinSize int
+
+	// CompressionEvents are event types to be compressed, every type is compressed if it is empty
+	CompressionEvents []string
+
+	// StreamHeartbeatInterval is an interval of heartbeat comments sent to server-sent events clients
+	StreamHeartbeatInterval time.Duration
+
+	// Authenticator authenticates connections with the AUTH method, authentication is disabled if it is nil
+	Authenticator Authenticator
+
+	// AuthTimeout is a duration that an unauthenticated connection has to send the AUTH method
+	AuthTimeout time.Duration
+
+	// Quota limits subscriptions and requests of each connection, nothing is limited if it is nil
+	Quota QuotaProvider
+
+	// BatchDisabled rejects batched subscriptions, when events of a round are not received together
+	BatchDisabled bool
 }
 
 // Factory is a factory for creating websocket clients
@@ -83,22 +162,63 @@ func NewFactory(c Config) (*Factory, error) {
 		return nil, fmt.Errorf("factory: PongWaitTimeout must be greater than PingInterval")
 	}
 
+	if c.StreamHeartbeatInterval <= 0 {
+		return nil, fmt.Errorf("factory: StreamHeartbeatInterval must be greater than zero")
+	}
+
+	if c.Authenticator != nil && c.AuthTimeout <= 0 {
+		return nil, fmt.Errorf("factory: AuthTimeout must be greater than zero")
+	}
+
 	return &Factory{
 		conf:  c,
 		total: atomic.NewUint64(0),
 	}, nil
 }
 
-func (cf *Factory) New(conn GorillaConnection) *Client {
+// New creates a websocket client
+func (cf *Factory) New(conn GorillaConnection, s Session) *Client {
 	id := cf.total.Add(1)
+	format := s.Format
+	if format == "" {
+		format = event.FormatJSON
+	}
+
+	version := s.Version
+	if version == 0 {
+		version = event.Version1
+	}
+
 	c := &Client{
 		conf:           cf.conf,
 		closeChan:      make(chan struct{}),
+		compression:    s.Compression,
 		conn:           conn,
+		format:         format,
 		id:             id,
 		isUnregistered: false,
+		jsonrpc:        s.JSONRPC,
 		mu:             sync.Mutex{},
-		sendChan:       make(chan []byte, cf.conf.SendBufferSize),
+		principal:      s.Principal,
+		release:        s.Release,
+		sendChan:       make(chan frame, cf.conf.SendBufferSize),
+		version:        version,
+	}
+	c.authenticated.Store(cf.conf.Authenticator == nil || s.Principal != nil)
+
+	if c.compression {
+		if err := conn.SetCompressionLevel(cf.conf.CompressionLevel); err != nil {
+			logger := c.logger()
+			logger.Warn().Err(err).Msg("client: failed to set compression level")
+		}
+	}
+
+	if !c.authenticated.Load() {
+		time.AfterFunc(cf.conf.AuthTimeout, func() {
+			if !c.authenticated.Load() {
+				c.CloseWithReason(CloseUnauthorized, "authentication timeout")
+			}
+		})
 	}
 
 	go c.write()
@@ -109,13 +229,24 @@ func (cf *Factory) New(conn GorillaConnection) *Client {
 
 // Client represents websocket client
 type Client struct {
+	authenticated      atomic.Bool
 	closeChan          chan struct{}
+	compression        bool
 	conn               GorillaConnection
 	conf               Config
+	format             string
 	id                 uint64
 	isUnregistered     bool
+	jsonrpc            bool
 	mu                 sync.Mutex
-	sendChan           chan []byte
+	principal          *auth.Principal
+	release            func()
+	requestLimiter     *rate.Limiter
+	sendChan           chan frame
+	subscriptionsMu    sync.RWMutex
+	subscriptions      []*subscription
+	lastSubscriptionID uint64
+	version            int
 	closeHandler       func()
 	subscribeHandler   func(params []string)
 	unsubscribeHandler func(params []string)
@@ -152,19 +283,171 @@ func (c *Client) IsClosed() bool {
 	return false
 }
 
-// Send sends a message to the peer
+// Send sends a control message to the peer in a text frame, it is sent as a notification in the JSON-RPC mode
 func (c *Client) Send(msg []byte) {
+	c.send(frame{messageType: websocket.TextMessage, data: c.notification(msg, "")})
+}
+
+// reply sends a response to the peer in a text frame
+func (c *Client) reply(res []byte) {
+	c.send(frame{messageType: websocket.TextMessage, data: res})
+}
+
+// notification returns an encoded event or control message as it is sent to the peer, subscriptionID may be empty
+func (c *Client) notification(payload []byte, subscriptionID string) []byte {
+	if !c.jsonrpc {
+		return payload
+	}
+
+	return newRPCNotification(payload, subscriptionID)
+}
+
+// SendEvent sends an event to the peer once per matching subscription, in the negotiated format with the subscribed fields.
+// Binary formats are sent in binary frames.
+func (c *Client) SendEvent(e *event.Event) {
+	for _, s := range c.activeSubscriptions() {
+		if s.has(e.Type) {
+			c.sendEvent(e, s)
+		}
+	}
+}
+
+// SendRound sends matched events of a round once per matching subscription,
+// events of each batched subscription are sent in one frame after the other ones
+func (c *Client) SendRound(events []*event.Event) {
+	subs := c.activeSubscriptions()
+	batches := make([][][]byte, len(subs))
+	compress := make([]bool, len(subs))
+	for _, e := range events {
+		for i, s := range subs {
+			if !s.has(e.Type) {
+				continue
+			}
+
+			if !s.batch {
+				c.sendEvent(e, s)
+
+				continue
+			}
+
+			bb, err := c.encode(e, s)
+			if err != nil {
+				logger := c.logger()
+				logger.Error().Err(err).Msgf("client: failed to encode an event to %s", c.format)
+
+				continue
+			}
+
+			batches[i] = append(batches[i], bb)
+			compress[i] = compress[i] || c.compressesType(e.Type)
+		}
+	}
+
+	for i, s := range subs {
+		if len(batches[i]) == 0 {
+			continue
+		}
+
+		bb, err := event.EncodeRound(c.format, c.version, c.tag(s), events[0].Round, events[0].Timestamp, batches[i])
+		if err != nil {
+			logger := c.logger()
+			logger.Error().Err(err).Msgf("client: failed to encode a round to %s", c.format)
+
+			continue
+		}
+
+		c.send(frame{messageType: c.messageType(), data: c.notification(bb, c.tag(s)), compress: compress[i] && len(bb) >= c.conf.CompressionMinSize})
+	}
+}
+
+func (c *Client) sendEvent(e *event.Event, s *subscription) {
+	bb, err := c.encode(e, s)
+	if err != nil {
+		logger := c.logger()
+		logger.Error().Err(err).Msgf("client: failed to encode an event to %s", c.format)
+
+		return
+	}
+
+	data := c.notification(bb, c.tag(s))
+	compress := c.compressesType(e.Type) && len(bb) >= c.conf.CompressionMinSize
+	if compress {
+		if size, err := compressedSize(e, c.format, s.projection, c.conf.CompressionLevel, len(data)); err == nil {
+			metrics.ObserveCompression(e.Type, len(data), size)
+		}
+	}
+
+	c.send(frame{messageType: c.messageType(), data: data, compress: compress})
+}
+
+// encode returns an event of a subscription in the negotiated format and protocol version
+func (c *Client) encode(e *event.Event, s *subscription) ([]byte, error) {
+	if c.version == event.Version1 {
+		return e.Encode(c.format, s.projection)
+	}
+
+	return e.EncodeEnvelope(c.format, s.projection, event.Delivery{
+		SubscriptionID: c.tag(s),
+		SentAt:         time.Now(),
+	})
+}
+
+// messageType returns a frame type of events, binary formats are sent in binary frames
+func (c *Client) messageType() int {
+	if c.format == event.FormatJSON {
+		return websocket.TextMessage
+	}
+
+	return websocket.BinaryMessage
+}
+
+// compressesType returns true if events of a type should be compressed when they are not smaller than the minimum size
+func (c *Client) compressesType(eventType string) bool {
+	if !c.compression {
+		return false
+	}
+
+	if len(c.conf.CompressionEvents) == 0 {
+		return true
+	}
+
+	for _, t := range c.conf.CompressionEvents {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Client) send(f frame) {
 	if c.IsClosed() {
 		return
 	}
 
 	select {
 	case <-c.closeChan:
-	case c.sendChan <- msg:
+	case c.sendChan <- f:
 	}
 }
 
+// Shutdown waits for pending messages to be written and closes the connection with a service restart code
+func (c *Client) Shutdown(reconnectAfter time.Duration) {
+	deadline := time.Now().Add(c.conf.WriteWaitTimeout)
+	for len(c.sendChan) > 0 && !c.IsClosed() && time.Now().Before(deadline) {
+		time.Sleep(time.Duration(10) * time.Millisecond)
+	}
+
+	c.CloseWithReason(websocket.CloseServiceRestart, RestartReason(reconnectAfter))
+}
+
+// Close closes the connection with a close code
 func (c *Client) Close(code int) {
+	c.CloseWithReason(code, "")
+}
+
+// CloseWithReason closes the connection with a close code and a reason
+func (c *Client) CloseWithReason(code int, reason string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -177,16 +460,24 @@ func (c *Client) Close(code int) {
 	close(c.sendChan)
 
 	_ = c.conn.SetWriteDeadline(time.Now().Add(c.conf.WriteWaitTimeout))
-	_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, ""))
+	_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason))
 	_ = c.conn.Close()
 
+	if c.release != nil {
+		c.release()
+	}
+
 	if c.closeHandler != nil {
 		c.closeHandler()
 	}
 }
 
 func (c *Client) read() {
-	defer c.Close(websocket.CloseGoingAway)
+	closeCode := websocket.CloseGoingAway
+	closeReason := ""
+	defer func() {
+		c.CloseWithReason(closeCode, closeReason)
+	}()
 
 	logger := c.logger()
 	if err := c.conn.SetReadDeadline(time.Now().Add(c.conf.PongWaitTimeout)); err != nil {
@@ -224,69 +515,79 @@ func (c *Client) read() {
 			continue
 		}
 
-		var payload Request
-		if err := json.Unmarshal(bb, &payload); err != nil {
-			response, err := newErrorResponse(0, invalidFormat, "payload is invalid")
-			if err != nil {
-				logger.Error().Err(err).Msg("client: failed to create an error response")
+		var res []byte
+		if c.jsonrpc {
+			res, err = c.serveRPC(bb)
+		} else {
+			res, err = c.serveLegacy(bb)
+		}
+		if err != nil {
+			if authErr, ok := err.(*authenticationError); ok {
+				closeCode = CloseUnauthorized
+				closeReason = authErr.Error()
 
 				return
 			}
 
-			c.Send(response)
+			logger.Error().Err(err).Msg("client: failed to create a response")
 
-			continue
+			return
 		}
 
-		switch payload.Method {
-		case methodSubscribe:
-			if err := c.validateSubscribing(payload); err != nil {
-				res, err := newErrorResponse(payload.ID, invalidFormat, err.Error())
-				if err != nil {
-					logger.Error().Err(err).Msg("client: failed to create an error response")
-
-					return
-				}
-
-				c.Send(res)
+		if res != nil {
+			c.reply(res)
+		}
+	}
+}
 
-				continue
-			}
+// serveLegacy processes a request of the legacy protocol, unknown methods are ignored
+func (c *Client) serveLegacy(bb []byte) ([]byte, error) {
+	var req Request
+	if err := json.Unmarshal(bb, &req); err != nil {
+		return newResponse(0, nil, newRequestError(errParse, "payload is invalid"))
+	}
 
-			c.subscribeHandler(payload.Params)
-			res, err := newSubscribingResponse(payload.ID)
-			if err != nil {
-				logger.Error().Err(err).Msg("client: failed to create a subscribing response")
+	result, rErr, err := c.serve(req)
+	if err != nil {
+		return nil, err
+	}
 
-				return
-			}
+	if rErr != nil && rErr.code == errMethodNotFound {
+		return nil, nil
+	}
 
-			c.Send(res)
-		case methodUnsubscribe:
-			if err := c.validateUnSubscribing(payload); err != nil {
-				res, err := newErrorResponse(payload.ID, invalidFormat, err.Error())
-				if err != nil {
-					logger.Error().Err(err).Msg("client: failed to create an error response")
+	return newResponse(req.ID, result, rErr)
+}
 
-					return
-				}
+// serve processes a request in either protocol, unauthenticated connections must authenticate first.
+// It returns an authenticationError if the connection must be closed.
+func (c *Client) serve(req Request) (interface{}, *requestError, error) {
+	if !c.authenticated.Load() {
+		if err := c.authenticate(req); err != nil {
+			return nil, nil, &authenticationError{reason: err.Error()}
+		}
 
-				c.Send(res)
+		return nil, nil, nil
+	}
 
-				continue
-			}
+	switch req.Method {
+	case methodAuth:
+		return nil, newRequestError(errInvalidRequest, "already authenticated"), nil
+	case methodSubscribe:
+		result, rErr := c.subscribe(req)
 
-			c.unsubscribeHandler(payload.Params)
-			res, err := newUnsubscribingResponse(payload.ID)
-			if err != nil {
-				logger.Error().Err(err).Msg("client: failed to create an unsubscribing response")
+		return result, rErr, nil
+	case methodUnsubscribe:
+		result, rErr := c.unsubscribe(req)
 
-				return
-			}
+		return result, rErr, nil
+	case methodListSubscriptions:
+		result, rErr := c.listSubscriptions(req)
 
-			c.Send(res)
-		}
+		return result, rErr, nil
 	}
+
+	return nil, newRequestError(errMethodNotFound, "method not found"), nil
 }
 
 func (c *Client) write() {
@@ -311,7 +612,7 @@ func (c *Client) write() {
 
 				return
 			}
-		case msg, open := <-c.sendChan:
+		case f, open := <-c.sendChan:
 			if !open {
 				return
 			}
@@ -322,7 +623,8 @@ func (c *Client) write() {
 				return
 			}
 
-			if err := c.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+			c.conn.EnableWriteCompression(f.compress)
+			if err := c.conn.WriteMessage(f.messageType, f.data); err != nil {
 				logger.Warn().Err(err).Msg("client: failed to send a message")
 
 				return
@@ -333,20 +635,95 @@ func (c *Client) write() {
 	}
 }
 
+// RestartReason returns a close reason asking the peer to reconnect after a delay
+func RestartReason(reconnectAfter time.Duration) string {
+	return fmt.Sprintf("server restarting, reconnect after %s", reconnectAfter)
+}
+
 func (c *Client) logger() zerolog.Logger {
 	return log.With().Fields(map[string]interface{}{
 		"client_id": c.ID(),
 	}).Logger()
 }
 
-func newErrorResponse(id uint64, code int, message string) ([]byte, error) {
-	bb, err := json.Marshal(Response{
-		ID: id,
-		Error: &ResponseError{
-			Code:    code,
-			Message: message,
-		},
-	})
+// limitRequest applies the request rate and the params limit of quotas
+func (c *Client) limitRequest(req Request) *quota.Error {
+	if c.conf.Quota == nil {
+		return nil
+	}
+
+	// the limiter is created lazily and follows reloaded quotas
+	q := c.conf.Quota.Config()
+	if q.RequestRate <= 0 {
+		c.requestLimiter = nil
+	} else if c.requestLimiter == nil {
+		c.requestLimiter = rate.NewLimiter(rate.Limit(q.RequestRate), q.RequestBurst)
+	} else if c.requestLimiter.Limit() != rate.Limit(q.RequestRate) || c.requestLimiter.Burst() != q.RequestBurst {
+		c.requestLimiter.SetLimit(rate.Limit(q.RequestRate))
+		c.requestLimiter.SetBurst(q.RequestBurst)
+	}
+
+	if c.requestLimiter != nil && !c.requestLimiter.Allow() {
+		return c.reject(quota.ReasonRateLimit, "too many requests")
+	}
+
+	if max := q.MaxParamsPerRequest; max > 0 && len(req.Params) > max {
+		return c.reject(quota.ReasonParamsLimit, fmt.Sprintf("params exceed the limit of %d", max))
+	}
+
+	return nil
+}
+
+// limitSubscribing applies the lower of the subscriptions limits of quotas and the principal to the subscriptions after a request
+func (c *Client) limitSubscribing(subs []*subscription) *quota.Error {
+	max := 0
+	if c.conf.Quota != nil {
+		max = c.conf.Quota.Config().MaxSubscriptionsPerConnection
+	}
+
+	if c.principal != nil && c.principal.MaxSubscriptions > 0 && (max <= 0 || c.principal.MaxSubscriptions < max) {
+		max = c.principal.MaxSubscriptions
+	}
+
+	if max <= 0 {
+		return nil
+	}
+
+	if subscribedEvents(subs) > max {
+		return c.reject(quota.ReasonSubscriptionLimit, fmt.Sprintf("subscriptions exceed the limit of %d", max))
+	}
+
+	return nil
+}
+
+func (c *Client) reject(reason, message string) *quota.Error {
+	tenant := "anonymous"
+	if c.principal != nil && c.principal.Subject != "" {
+		tenant = c.principal.Subject
+	}
+
+	metrics.ObserveQuotaRejection(tenant, reason)
+
+	return &quota.Error{
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func newResponse(id uint64, result interface{}, rErr *requestError) ([]byte, error) {
+	res := Response{
+		ID:     id,
+		Result: result,
+	}
+	if rErr != nil {
+		res.Error = &ResponseError{
+			Code:    rErr.code.legacy,
+			Reason:  rErr.reason,
+			Message: rErr.message,
+		}
+	}
+
+	bb, err := json.Marshal(res)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,189 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/quota"
+)
+
+// newTestClient creates an authenticated client without a connection, requests are served by calling serve* directly
+func newTestClient(conf Config, s Session) *Client {
+	c := &Client{
+		conf:               conf,
+		closeChan:          make(chan struct{}),
+		format:             event.FormatJSON,
+		jsonrpc:            s.JSONRPC,
+		principal:          s.Principal,
+		sendChan:           make(chan frame, 16),
+		version:            event.Version1,
+		subscribeHandler:   func(params []string) {},
+		unsubscribeHandler: func(params []string) {},
+	}
+	if s.Version != 0 {
+		c.version = s.Version
+	}
+	c.authenticated.Store(true)
+
+	return c
+}
+
+func TestLimitRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     quota.Config
+		params   []string
+		requests int
+		reasons  []string
+	}{
+		{
+			name:     "unlimited",
+			conf:     quota.Config{},
+			params:   []string{event.NewBlock, event.NewPaymentTx},
+			requests: 3,
+			reasons:  []string{"", "", ""},
+		},
+		{
+			name:     "request rate",
+			conf:     quota.Config{RequestRate: 0.001, RequestBurst: 2},
+			params:   []string{event.NewBlock},
+			requests: 3,
+			reasons:  []string{"", "", quota.ReasonRateLimit},
+		},
+		{
+			name:     "params",
+			conf:     quota.Config{MaxParamsPerRequest: 1},
+			params:   []string{event.NewBlock, event.NewPaymentTx},
+			requests: 1,
+			reasons:  []string{quota.ReasonParamsLimit},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(Config{Quota: quota.New(tc.conf)}, Session{})
+			for i := 0; i < tc.requests; i++ {
+				reason := ""
+				if qErr := c.limitRequest(Request{Method: methodSubscribe, Params: tc.params}); qErr != nil {
+					reason = qErr.Reason
+				}
+
+				if reason != tc.reasons[i] {
+					t.Fatalf("request %d was rejected with %q, want %q", i, reason, tc.reasons[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLimitRequestFollowsReloadedQuotas(t *testing.T) {
+	q := quota.New(quota.Config{RequestRate: 0.001, RequestBurst: 1})
+	c := newTestClient(Config{Quota: q}, Session{})
+
+	if qErr := c.limitRequest(Request{}); qErr != nil {
+		t.Fatalf("the first request was rejected: %v", qErr)
+	}
+
+	if qErr := c.limitRequest(Request{}); qErr == nil {
+		t.Fatal("a request over the rate was accepted")
+	}
+
+	q.SetConfig(quota.Config{})
+	if qErr := c.limitRequest(Request{}); qErr != nil {
+		t.Fatalf("a request was rejected after the rate limit was removed: %v", qErr)
+	}
+}
+
+func TestLimitSubscribing(t *testing.T) {
+	subs := []*subscription{
+		{id: "1", events: []string{event.NewBlock, event.NewPaymentTx}},
+		{id: "2", events: []string{event.NewPaymentTx}},
+	}
+
+	tests := []struct {
+		name      string
+		quota     int
+		principal int
+		want      bool
+	}{
+		{name: "unlimited", want: false},
+		{name: "under the quota", quota: 3, want: false},
+		{name: "over the quota", quota: 2, want: true},
+		{name: "over the principal limit", principal: 2, want: true},
+		{name: "the principal limit is lower", quota: 5, principal: 2, want: true},
+		{name: "the quota is lower", quota: 2, principal: 5, want: true},
+		{name: "under both limits", quota: 3, principal: 4, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(Config{
+				Quota: quota.New(quota.Config{MaxSubscriptionsPerConnection: tc.quota}),
+			}, Session{
+				Principal: &auth.Principal{Subject: "a", MaxSubscriptions: tc.principal},
+			})
+
+			qErr := c.limitSubscribing(subs)
+			if rejected := qErr != nil; rejected != tc.want {
+				t.Fatalf("rejected %v, want %v", rejected, tc.want)
+			}
+
+			if qErr != nil && qErr.Reason != quota.ReasonSubscriptionLimit {
+				t.Fatalf("rejected with the reason %s", qErr.Reason)
+			}
+		})
+	}
+}
+
+// frames returns the frames queued for the peer
+func frames(c *Client) []frame {
+	var fs []frame
+	for len(c.sendChan) > 0 {
+		fs = append(fs, <-c.sendChan)
+	}
+
+	return fs
+}
+
+func TestSendRound(t *testing.T) {
+	c := newTestClient(Config{}, Session{})
+	for _, req := range []Request{
+		{Method: methodSubscribe, Params: []string{event.NewBlock}},
+		{Method: methodSubscribe, Params: []string{event.NewPaymentTx}, Batch: true},
+	} {
+		if _, rErr := c.subscribe(req); rErr != nil {
+			t.Fatalf("failed to subscribe: %v", rErr.message)
+		}
+	}
+
+	c.SendRound([]*event.Event{
+		{Type: event.NewBlock, Round: 7, Timestamp: 100, Payload: []byte(`{"eventType":"NEW_BLOCK"}`)},
+		{Type: event.NewPaymentTx, Round: 7, Timestamp: 100, Payload: []byte(`{"eventType":"NEW_PAYMENT_TX","data":{"id":"A"}}`)},
+		{Type: event.NewAssetTransferTx, Round: 7, Timestamp: 100, Payload: []byte(`{"eventType":"NEW_ASSET_TRANSFER_TX"}`)},
+		{Type: event.NewPaymentTx, Round: 7, Timestamp: 100, Payload: []byte(`{"eventType":"NEW_PAYMENT_TX","data":{"id":"B"}}`)},
+	})
+
+	// events of subscriptions without batch are sent first, then a batch of each subscription
+	want := []string{
+		`{"eventType":"NEW_BLOCK"}`,
+		`{"eventType":"ROUND_BATCH","round":7,"timestamp":100,"events":[{"eventType":"NEW_PAYMENT_TX","data":{"id":"A"}},{"eventType":"NEW_PAYMENT_TX","data":{"id":"B"}}]}`,
+	}
+
+	got := frames(c)
+	if len(got) != len(want) {
+		t.Fatalf("sent %d frames, want %d", len(got), len(want))
+	}
+
+	for i, f := range got {
+		if string(f.data) != want[i] {
+			t.Errorf("frame %d is %s, want %s", i, f.data, want[i])
+		}
+	}
+
+	// a round without subscribed events is not sent
+	c.SendRound([]*event.Event{{Type: event.NewAssetTransferTx, Round: 8, Payload: []byte(`{}`)}})
+	if fs := frames(c); len(fs) != 0 {
+		t.Fatalf("sent %d frames of a round without subscribed events", len(fs))
+	}
+}
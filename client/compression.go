@@ -0,0 +1,53 @@
+package client
+
+import (
+	"bytes"
+	"compress/flate"
+	"strconv"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+// compressedSize returns the size of a frame of an event compressed with permessage-deflate. The shared encoding of the
+// event is compressed once per event, encoding and level, and the size of frames with metadata of a delivery, such as
+// envelopes, is estimated with its ratio, so that nothing is compressed again for each connection.
+// It is only used for metrics, since the connection does not expose the size of a written frame.
+func compressedSize(e *event.Event, format string, p *event.Projection, level int, frameSize int) (int, error) {
+	shared, err := e.Encode(format, p)
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := e.Cached("deflate:"+event.EncodingKey(format, p)+":"+strconv.Itoa(level), func() (interface{}, error) {
+		return deflatedSize(level, shared)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	size := v.(int)
+	if frameSize == len(shared) || len(shared) == 0 {
+		return size, nil
+	}
+
+	return size * frameSize / len(shared), nil
+}
+
+func deflatedSize(level int, data []byte) (int, error) {
+	var buf bytes.Buffer
+	fw, err := flate.NewWriter(&buf, level)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := fw.Write(data); err != nil {
+		return 0, err
+	}
+
+	if err := fw.Flush(); err != nil {
+		return 0, err
+	}
+
+	// the empty block ending a flushed message is not sent
+	return buf.Len() - 4, nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"github.com/synycboom/algorand-notification/quota"
+)
+
+// errorCode is an error of the catalog shared by both protocols, with its code in each of them
+type errorCode struct {
+	legacy  int
+	jsonrpc int
+}
+
+var (
+	// errParse is a request that is not valid JSON
+	errParse = errorCode{legacy: 400, jsonrpc: -32700}
+
+	// errInvalidRequest is a request that is not a valid request object
+	errInvalidRequest = errorCode{legacy: 400, jsonrpc: -32600}
+
+	// errMethodNotFound is a request of an unknown method, it is only responded in JSON-RPC mode
+	errMethodNotFound = errorCode{jsonrpc: -32601}
+
+	// errInvalidParams is a request with invalid params or fields
+	errInvalidParams = errorCode{legacy: 400, jsonrpc: -32602}
+
+	// errForbidden is a request for events that the principal is not allowed to subscribe
+	errForbidden = errorCode{legacy: 403, jsonrpc: -32003}
+
+	// errQuota is a request rejected by quotas
+	errQuota = errorCode{legacy: 429, jsonrpc: -32029}
+)
+
+// requestError is an error response of a request
+type requestError struct {
+	code    errorCode
+	reason  string
+	message string
+}
+
+func newRequestError(code errorCode, message string) *requestError {
+	return &requestError{
+		code:    code,
+		message: message,
+	}
+}
+
+func newQuotaError(qErr *quota.Error) *requestError {
+	return &requestError{
+		code:    errQuota,
+		reason:  qErr.Reason,
+		message: qErr.Message,
+	}
+}
+
+// authenticationError closes a connection that failed to authenticate
+type authenticationError struct {
+	reason string
+}
+
+func (e *authenticationError) Error() string {
+	return e.reason
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/interest"
+	notificationv1 "github.com/synycboom/algorand-notification/proto/notification/v1"
+)
+
+// EventStream represents a contract for a gRPC server stream
+type EventStream interface {
+	Send(*notificationv1.Event) error
+}
+
+// NewGRPC creates a gRPC streaming client
+func (cf *Factory) NewGRPC(stream EventStream, sc StreamConfig) *GRPCClient {
+	return &GRPCClient{
+		closeChan:    make(chan struct{}),
+		id:           cf.total.Add(1),
+		mu:           sync.Mutex{},
+		sendChan:     make(chan *event.Event, cf.conf.SendBufferSize),
+		shutdownChan: make(chan time.Duration),
+		stream:       stream,
+		sub:          sc,
+	}
+}
+
+// GRPCClient represents a gRPC streaming client
+type GRPCClient struct {
+	closeChan          chan struct{}
+	id                 uint64
+	mu                 sync.Mutex
+	sendChan           chan *event.Event
+	shutdownChan       chan time.Duration
+	stream             EventStream
+	sub                StreamConfig
+	closeHandler       func()
+	subscribeHandler   func(params []string)
+	unsubscribeHandler func(params []string)
+}
+
+// ID returns a client id
+func (c *GRPCClient) ID() uint64 {
+	return c.id
+}
+
+// OnClose sets a close handler
+func (c *GRPCClient) OnClose(h func()) {
+	c.closeHandler = h
+}
+
+// OnSubscribe sets a subscribing handler
+func (c *GRPCClient) OnSubscribe(h func(params []string)) {
+	c.subscribeHandler = h
+}
+
+// OnUnsubscribe sets a unsubscribing handler
+func (c *GRPCClient) OnUnsubscribe(h func(params []string)) {
+	c.unsubscribeHandler = h
+}
+
+// IsClosed returns true if the client was closed
+func (c *GRPCClient) IsClosed() bool {
+	select {
+	case <-c.closeChan:
+		return true
+	default:
+	}
+
+	return false
+}
+
+// Subscribe subscribes the client to its configured events, the client must be registered to a hub first
+func (c *GRPCClient) Subscribe() {
+	if c.subscribeHandler != nil {
+		c.subscribeHandler(c.sub.Types)
+	}
+}
+
+// Filter returns a filter of the subscription
+func (c *GRPCClient) Filter() interest.Filter {
+	return c.sub.Filter()
+}
+
+// Send does nothing since gRPC clients only receive typed events through SendEvent
+func (c *GRPCClient) Send(msg []byte) {}
+
+// SendEvent sends an event to the peer if it matches the subscription
+func (c *GRPCClient) SendEvent(e *event.Event) {
+	if c.IsClosed() {
+		return
+	}
+
+	if !c.sub.Filter().Matches(e) {
+		return
+	}
+
+	select {
+	case <-c.closeChan:
+	case c.sendChan <- e:
+	}
+}
+
+// Serve sends events to the peer until the context is done or sending fails
+func (c *GRPCClient) Serve(ctx context.Context) error {
+	defer c.Close()
+
+	logger := c.logger()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.closeChan:
+			return nil
+		case e := <-c.sendChan:
+			if err := c.send(e); err != nil {
+				logger.Warn().Err(err).Msg("client: failed to send an event")
+
+				return err
+			}
+		case reconnectAfter := <-c.shutdownChan:
+			for len(c.sendChan) > 0 {
+				if err := c.send(<-c.sendChan); err != nil {
+					logger.Warn().Err(err).Msg("client: failed to send an event")
+
+					return err
+				}
+			}
+
+			// UNAVAILABLE is retryable, so the peer reconnects after the hinted delay
+			return status.Error(codes.Unavailable, RestartReason(reconnectAfter))
+		}
+	}
+}
+
+// Shutdown asks Serve to send pending events and end the stream with a reconnect hint
+func (c *GRPCClient) Shutdown(reconnectAfter time.Duration) {
+	select {
+	case <-c.closeChan:
+	case c.shutdownChan <- reconnectAfter:
+	}
+}
+
+// Close closes the client
+func (c *GRPCClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.IsClosed() {
+		return
+	}
+
+	close(c.closeChan)
+
+	if c.closeHandler != nil {
+		c.closeHandler()
+	}
+}
+
+func (c *GRPCClient) send(e *event.Event) error {
+	msg, err := e.Proto()
+	if err != nil {
+		logger := c.logger()
+		logger.Error().Err(err).Msg("client: failed to convert an event to protobuf")
+
+		return nil
+	}
+
+	return c.stream.Send(msg)
+}
+
+func (c *GRPCClient) logger() zerolog.Logger {
+	return log.With().Fields(map[string]interface{}{
+		"client_id": c.ID(),
+		"transport": "grpc",
+	}).Logger()
+}
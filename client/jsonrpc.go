@@ -0,0 +1,184 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+const (
+	// JSONRPCVersion is the version of the JSON-RPC protocol mode
+	JSONRPCVersion = "2.0"
+
+	methodSubscription = "subscription"
+)
+
+// rpcRequest is a JSON-RPC 2.0 request, the id is nil for notifications
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+// rpcParams are named params of a JSON-RPC request
+type rpcParams struct {
+	Events []string `json:"events"`
+	Fields []string `json:"fields"`
+	Batch  bool     `json:"batch"`
+	// Subscriptions are ids of subscriptions to unsubscribe
+	Subscriptions []string `json:"subscriptions"`
+}
+
+// rpcResponse is a JSON-RPC 2.0 response, it has either a result or an error
+type rpcResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      json.RawMessage  `json:"id"`
+	Result  *json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError        `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// request converts the JSON-RPC request, params are either a list of events or named params
+func (r rpcRequest) request() (Request, bool) {
+	req := Request{Method: r.Method}
+	params := bytes.TrimSpace(r.Params)
+	if len(params) == 0 || bytes.Equal(params, []byte("null")) {
+		return req, true
+	}
+
+	switch params[0] {
+	case '[':
+		return req, json.Unmarshal(params, &req.Params) == nil
+	case '{':
+		var p rpcParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return req, false
+		}
+
+		req.Params = append(p.Events, p.Subscriptions...)
+		req.Fields = p.Fields
+		req.Batch = p.Batch
+
+		return req, true
+	}
+
+	return req, false
+}
+
+// serveRPC processes a JSON-RPC request or a batch of them, it returns nil if nothing has to be responded
+func (c *Client) serveRPC(bb []byte) ([]byte, error) {
+	bb = bytes.TrimSpace(bb)
+	if !json.Valid(bb) {
+		return newRPCResponse(nil, nil, newRequestError(errParse, "payload is invalid"))
+	}
+
+	if bb[0] != '[' {
+		return c.serveRPCRequest(bb)
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(bb, &batch); err != nil {
+		return newRPCResponse(nil, nil, newRequestError(errParse, "payload is invalid"))
+	}
+
+	if len(batch) == 0 {
+		return newRPCResponse(nil, nil, newRequestError(errInvalidRequest, "batch is empty"))
+	}
+
+	// requests of a batch are processed in order, notifications are not responded
+	responses := make([]json.RawMessage, 0, len(batch))
+	for _, raw := range batch {
+		res, err := c.serveRPCRequest(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		if res != nil {
+			responses = append(responses, res)
+		}
+	}
+
+	if len(responses) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(responses)
+}
+
+func (c *Client) serveRPCRequest(raw json.RawMessage) ([]byte, error) {
+	var r rpcRequest
+	if err := json.Unmarshal(raw, &r); err != nil || r.JSONRPC != JSONRPCVersion || r.Method == "" {
+		return newRPCResponse(r.ID, nil, newRequestError(errInvalidRequest, "request is invalid"))
+	}
+
+	req, ok := r.request()
+	if !ok {
+		if r.ID == nil {
+			return nil, nil
+		}
+
+		return newRPCResponse(r.ID, nil, newRequestError(errInvalidParams, "invalid params"))
+	}
+
+	result, rErr, err := c.serve(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.ID == nil {
+		return nil, nil
+	}
+
+	return newRPCResponse(r.ID, result, rErr)
+}
+
+func newRPCResponse(id json.RawMessage, result interface{}, rErr *requestError) ([]byte, error) {
+	res := rpcResponse{
+		JSONRPC: JSONRPCVersion,
+		ID:      id,
+	}
+
+	if rErr != nil {
+		res.Error = &rpcError{
+			Code:    rErr.code.jsonrpc,
+			Message: rErr.message,
+		}
+		if rErr.reason != "" {
+			res.Error.Data = map[string]string{"reason": rErr.reason}
+		}
+	} else {
+		bb, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+
+		raw := json.RawMessage(bb)
+		res.Result = &raw
+	}
+
+	return json.Marshal(res)
+}
+
+// newRPCNotification wraps an event payload encoded in JSON in a subscription notification without decoding it,
+// subscriptionID is omitted if it is empty
+func newRPCNotification(payload []byte, subscriptionID string) []byte {
+	const prefix = `{"jsonrpc":"` + JSONRPCVersion + `","method":"` + methodSubscription + `","params":{`
+
+	bb := make([]byte, 0, len(prefix)+len(subscriptionID)+len(payload)+32)
+	bb = append(bb, prefix...)
+	if subscriptionID != "" {
+		bb = append(bb, `"subscription":`...)
+		bb = strconv.AppendQuote(bb, subscriptionID)
+		bb = append(bb, ',')
+	}
+	bb = append(bb, `"result":`...)
+	bb = append(bb, payload...)
+
+	return append(bb, "}}"...)
+}
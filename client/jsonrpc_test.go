@@ -0,0 +1,233 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/quota"
+)
+
+func TestServeRPC(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    Config
+		session Session
+		req     string
+		// want is nil if nothing is responded
+		want *string
+	}{
+		{
+			name: "parse error",
+			req:  `{"jsonrpc":"2.0","id":1,`,
+			want: str(`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"payload is invalid"}}`),
+		},
+		{
+			name: "empty batch",
+			req:  `[]`,
+			want: str(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch is empty"}}`),
+		},
+		{
+			name: "not a request object",
+			req:  `1`,
+			want: str(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"request is invalid"}}`),
+		},
+		{
+			name: "wrong version",
+			req:  `{"jsonrpc":"1.0","id":1,"method":"SUBSCRIBE","params":["NEW_BLOCK"]}`,
+			want: str(`{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"request is invalid"}}`),
+		},
+		{
+			name: "subscribe with a list of events",
+			req:  `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["NEW_BLOCK"]}`,
+			want: str(`{"jsonrpc":"2.0","id":1,"result":"1"}`),
+		},
+		{
+			name: "subscribe with named params and a string id",
+			req:  `{"jsonrpc":"2.0","id":"a","method":"SUBSCRIBE","params":{"events":["NEW_PAYMENT_TX"],"fields":["id"],"batch":true}}`,
+			want: str(`{"jsonrpc":"2.0","id":"a","result":"1"}`),
+		},
+		{
+			name: "notification",
+			req:  `{"jsonrpc":"2.0","method":"SUBSCRIBE","params":["NEW_BLOCK"]}`,
+			want: nil,
+		},
+		{
+			name: "malformed params with an id",
+			req:  `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":"NEW_BLOCK"}`,
+			want: str(`{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid params"}}`),
+		},
+		{
+			name: "malformed params without an id",
+			req:  `{"jsonrpc":"2.0","method":"SUBSCRIBE","params":"NEW_BLOCK"}`,
+			want: nil,
+		},
+		{
+			name: "unknown events with an id",
+			req:  `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["UNKNOWN"]}`,
+			want: str(`{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid params"}}`),
+		},
+		{
+			name: "unknown events without an id",
+			req:  `{"jsonrpc":"2.0","method":"SUBSCRIBE","params":["UNKNOWN"]}`,
+			want: nil,
+		},
+		{
+			name: "method not found",
+			req:  `{"jsonrpc":"2.0","id":1,"method":"PING"}`,
+			want: str(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`),
+		},
+		{
+			name: "all notifications batch",
+			req:  `[{"jsonrpc":"2.0","method":"SUBSCRIBE","params":["NEW_BLOCK"]},{"jsonrpc":"2.0","method":"UNSUBSCRIBE","params":["NEW_BLOCK"]}]`,
+			want: nil,
+		},
+		{
+			name: "mixed batch",
+			req:  `[{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["NEW_BLOCK"]},{"jsonrpc":"2.0","method":"SUBSCRIBE","params":["NEW_PAYMENT_TX"]},1,{"jsonrpc":"2.0","id":2,"method":"LIST_SUBSCRIPTIONS"}]`,
+			want: str(`[{"jsonrpc":"2.0","id":1,"result":"1"},{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"request is invalid"}},{"jsonrpc":"2.0","id":2,"result":[{"id":"1","events":["NEW_BLOCK"]},{"id":"2","events":["NEW_PAYMENT_TX"]}]}]`),
+		},
+		{
+			name: "unsubscribe by subscription id",
+			req:  `[{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["NEW_BLOCK"]},{"jsonrpc":"2.0","id":2,"method":"UNSUBSCRIBE","params":{"subscriptions":["1"]}},{"jsonrpc":"2.0","id":3,"method":"LIST_SUBSCRIPTIONS"}]`,
+			want: str(`[{"jsonrpc":"2.0","id":1,"result":"1"},{"jsonrpc":"2.0","id":2,"result":null},{"jsonrpc":"2.0","id":3,"result":[]}]`),
+		},
+		{
+			name:    "forbidden events",
+			session: Session{Principal: &auth.Principal{Subject: "a", Events: []string{"NEW_BLOCK"}}},
+			req:     `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["NEW_PAYMENT_TX"]}`,
+			want:    str(`{"jsonrpc":"2.0","id":1,"error":{"code":-32003,"message":"event NEW_PAYMENT_TX is not allowed"}}`),
+		},
+		{
+			name: "quota",
+			conf: Config{Quota: quota.New(quota.Config{MaxParamsPerRequest: 1})},
+			req:  `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["NEW_BLOCK","NEW_PAYMENT_TX"]}`,
+			want: str(`{"jsonrpc":"2.0","id":1,"error":{"code":-32029,"message":"params exceed the limit of 1","data":{"reason":"PARAMS_LIMIT"}}}`),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.session.JSONRPC = true
+			c := newTestClient(tc.conf, tc.session)
+
+			res, err := c.serveRPC([]byte(tc.req))
+			if err != nil {
+				t.Fatalf("failed to serve: %v", err)
+			}
+
+			if tc.want == nil {
+				if res != nil {
+					t.Fatalf("responded %s to notifications", res)
+				}
+
+				return
+			}
+
+			if string(res) != *tc.want {
+				t.Fatalf("got  %s\nwant %s", res, *tc.want)
+			}
+		})
+	}
+}
+
+func str(s string) *string {
+	return &s
+}
+
+func TestErrorCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    Config
+		session Session
+		legacy  string
+		rpc     string
+		// code is a legacy error code, zero if legacy connections ignore the request
+		code    int
+		rpcCode int
+		reason  string
+	}{
+		{
+			name:    "parse error",
+			legacy:  `{"method":`,
+			rpc:     `{"method":`,
+			code:    400,
+			rpcCode: -32700,
+		},
+		{
+			name:    "already authenticated",
+			legacy:  `{"id":1,"method":"AUTH","params":["token"]}`,
+			rpc:     `{"jsonrpc":"2.0","id":1,"method":"AUTH","params":["token"]}`,
+			code:    400,
+			rpcCode: -32600,
+		},
+		{
+			name:    "method not found",
+			legacy:  `{"id":1,"method":"PING"}`,
+			rpc:     `{"jsonrpc":"2.0","id":1,"method":"PING"}`,
+			code:    0,
+			rpcCode: -32601,
+		},
+		{
+			name:    "invalid params",
+			legacy:  `{"id":1,"method":"SUBSCRIBE","params":["UNKNOWN"]}`,
+			rpc:     `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["UNKNOWN"]}`,
+			code:    400,
+			rpcCode: -32602,
+		},
+		{
+			name:    "forbidden",
+			session: Session{Principal: &auth.Principal{Subject: "a", Events: []string{"NEW_BLOCK"}}},
+			legacy:  `{"id":1,"method":"SUBSCRIBE","params":["NEW_PAYMENT_TX"]}`,
+			rpc:     `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["NEW_PAYMENT_TX"]}`,
+			code:    403,
+			rpcCode: -32003,
+		},
+		{
+			name:    "quota",
+			conf:    Config{Quota: quota.New(quota.Config{MaxParamsPerRequest: 1})},
+			legacy:  `{"id":1,"method":"SUBSCRIBE","params":["NEW_BLOCK","NEW_PAYMENT_TX"]}`,
+			rpc:     `{"jsonrpc":"2.0","id":1,"method":"SUBSCRIBE","params":["NEW_BLOCK","NEW_PAYMENT_TX"]}`,
+			code:    429,
+			rpcCode: -32029,
+			reason:  quota.ReasonParamsLimit,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.code == 0 {
+				bb, err := newTestClient(tc.conf, tc.session).serveLegacy([]byte(tc.legacy))
+				if err != nil || bb != nil {
+					t.Fatalf("legacy connection responded %s, %v", bb, err)
+				}
+			} else {
+				res := legacyResponse(t, newTestClient(tc.conf, tc.session), tc.legacy)
+				if res.Error == nil || res.Error.Code != tc.code || res.Error.Reason != tc.reason {
+					t.Fatalf("legacy error is %+v, want %d %q", res.Error, tc.code, tc.reason)
+				}
+			}
+
+			tc.session.JSONRPC = true
+			bb, err := newTestClient(tc.conf, tc.session).serveRPC([]byte(tc.rpc))
+			if err != nil {
+				t.Fatalf("failed to serve: %v", err)
+			}
+
+			var res rpcResponse
+			if err := json.Unmarshal(bb, &res); err != nil {
+				t.Fatalf("failed to decode the response %s: %v", bb, err)
+			}
+
+			if res.Error == nil || res.Error.Code != tc.rpcCode {
+				t.Fatalf("JSON-RPC error is %+v, want %d", res.Error, tc.rpcCode)
+			}
+
+			data, _ := res.Error.Data.(map[string]interface{})
+			reason, _ := data["reason"].(string)
+			if reason != tc.reason {
+				t.Fatalf("JSON-RPC reason is %q, want %q", reason, tc.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.uber.org/atomic"
+
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/interest"
+)
+
+// StreamWriter represents a contract for a http response used by server-sent events
+type StreamWriter interface {
+	io.Writer
+	Flush()
+}
+
+// StreamConfig represents a server-sent events subscription
+type StreamConfig struct {
+	Types   []string
+	Address string
+	AssetID uint64
+	AppID   uint64
+	// Version is a protocol version of events, it is the version 1 if zero
+	Version int
+}
+
+// Filter returns a filter of the subscription
+func (sc StreamConfig) Filter() interest.Filter {
+	return interest.Filter{
+		Address: sc.Address,
+		AssetID: sc.AssetID,
+		AppID:   sc.AppID,
+	}
+}
+
+// NewStream creates a server-sent events client
+func (cf *Factory) NewStream(w StreamWriter, sc StreamConfig) *StreamClient {
+	return &StreamClient{
+		closeChan:    make(chan struct{}),
+		conf:         cf.conf,
+		id:           cf.total.Add(1),
+		mu:           sync.Mutex{},
+		sendChan:     make(chan *event.Event, cf.conf.SendBufferSize),
+		shutdownChan: make(chan time.Duration),
+		stream:       sc,
+		w:            w,
+	}
+}
+
+// StreamClient represents a server-sent events client
+type StreamClient struct {
+	closeChan          chan struct{}
+	conf               Config
+	id                 uint64
+	lastSeq            uint64
+	mu                 sync.Mutex
+	replaying          atomic.Bool
+	sendChan           chan *event.Event
+	shutdownChan       chan time.Duration
+	stream             StreamConfig
+	w                  StreamWriter
+	closeHandler       func()
+	subscribeHandler   func(params []string)
+	unsubscribeHandler func(params []string)
+}
+
+// ID returns a client id
+func (c *StreamClient) ID() uint64 {
+	return c.id
+}
+
+// OnClose sets a close handler
+func (c *StreamClient) OnClose(h func()) {
+	c.closeHandler = h
+}
+
+// OnSubscribe sets a subscribing handler
+func (c *StreamClient) OnSubscribe(h func(params []string)) {
+	c.subscribeHandler = h
+}
+
+// OnUnsubscribe sets a unsubscribing handler
+func (c *StreamClient) OnUnsubscribe(h func(params []string)) {
+	c.unsubscribeHandler = h
+}
+
+// IsClosed returns true if the client was closed
+func (c *StreamClient) IsClosed() bool {
+	select {
+	case <-c.closeChan:
+		return true
+	default:
+	}
+
+	return false
+}
+
+// Filter returns a filter of the subscription
+func (c *StreamClient) Filter() interest.Filter {
+	return c.stream.Filter()
+}
+
+// Subscribe subscribes the client to its configured events, the client must be registered to a hub first
+func (c *StreamClient) Subscribe() {
+	if c.subscribeHandler != nil {
+		c.subscribeHandler(c.stream.Types)
+	}
+}
+
+// Send sends a message without metadata to the peer
+func (c *StreamClient) Send(msg []byte) {
+	c.SendEvent(&event.Event{Payload: msg})
+}
+
+// SendEvent sends an event to the peer if it matches the subscription
+func (c *StreamClient) SendEvent(e *event.Event) {
+	if c.IsClosed() {
+		return
+	}
+
+	if !c.stream.Filter().Matches(e) {
+		return
+	}
+
+	if c.replaying.Load() {
+		select {
+		case c.sendChan <- e:
+		default:
+			// the peer reconnects with its last event id, so it misses nothing. The hub waits for this call to return,
+			// so the client is closed in another goroutine.
+			logger := c.logger()
+			logger.Warn().Msg("client: closing a stream whose buffer is full while replaying")
+			go c.Close()
+		}
+
+		return
+	}
+
+	select {
+	case <-c.closeChan:
+	case c.sendChan <- e:
+	}
+}
+
+// Replay writes events returned by next until it returns no events, then live events are sent again.
+// Live events are buffered in the meantime, and the client is closed instead of blocking the hub if the buffer is full.
+// It must be called before Serve.
+func (c *StreamClient) Replay(next func() ([]*event.Event, error)) error {
+	c.replaying.Store(true)
+	defer c.replaying.Store(false)
+
+	for !c.IsClosed() {
+		events, err := next()
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		for _, e := range events {
+			if err := c.Write(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Write writes an event to the peer synchronously if it matches the subscription, it must not be called after Serve
+func (c *StreamClient) Write(e *event.Event) error {
+	if !c.stream.Filter().Matches(e) {
+		return nil
+	}
+
+	if e.Seq != 0 && e.Seq <= c.lastSeq {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if e.Seq != 0 {
+		buf.WriteString("id: " + strconv.FormatUint(e.Seq, 10) + "\n")
+		c.lastSeq = e.Seq
+	}
+
+	payload := e.Payload
+	if c.stream.Version == event.Version2 && e.Type != "" {
+		var err error
+		if payload, err = e.EncodeEnvelope(event.FormatJSON, nil, event.Delivery{SentAt: time.Now()}); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteString("data: ")
+	buf.Write(payload)
+	buf.WriteString("\n\n")
+
+	if _, err := c.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	c.w.Flush()
+
+	return nil
+}
+
+// Serve writes events and heartbeats to the peer until the context is done or writing fails
+func (c *StreamClient) Serve(ctx context.Context) {
+	defer c.Close()
+
+	ticker := time.NewTicker(c.conf.StreamHeartbeatInterval)
+	defer ticker.Stop()
+
+	logger := c.logger()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.closeChan:
+			return
+		case <-ticker.C:
+			if _, err := c.w.Write([]byte(": heartbeat\n\n")); err != nil {
+				logger.Warn().Err(err).Msg("client: failed to send a heartbeat")
+
+				return
+			}
+
+			c.w.Flush()
+		case e := <-c.sendChan:
+			if err := c.Write(e); err != nil {
+				logger.Warn().Err(err).Msg("client: failed to send an event")
+
+				return
+			}
+		case reconnectAfter := <-c.shutdownChan:
+			for len(c.sendChan) > 0 {
+				if err := c.Write(<-c.sendChan); err != nil {
+					logger.Warn().Err(err).Msg("client: failed to send an event")
+
+					return
+				}
+			}
+
+			// the retry field tells EventSource when to reconnect
+			retry := "retry: " + strconv.FormatInt(reconnectAfter.Milliseconds(), 10) + "\n\n"
+			if _, err := c.w.Write([]byte(retry)); err != nil {
+				logger.Warn().Err(err).Msg("client: failed to send a reconnect hint")
+
+				return
+			}
+
+			c.w.Flush()
+
+			return
+		}
+	}
+}
+
+// Shutdown asks Serve to write pending events and a reconnect hint, then close the stream
+func (c *StreamClient) Shutdown(reconnectAfter time.Duration) {
+	select {
+	case <-c.closeChan:
+	case c.shutdownChan <- reconnectAfter:
+	}
+}
+
+// Close closes the client
+func (c *StreamClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.IsClosed() {
+		return
+	}
+
+	close(c.closeChan)
+
+	if c.closeHandler != nil {
+		c.closeHandler()
+	}
+}
+
+func (c *StreamClient) logger() zerolog.Logger {
+	return log.With().Fields(map[string]interface{}{
+		"client_id": c.ID(),
+		"transport": "sse",
+	}).Logger()
+}
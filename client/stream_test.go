@@ -0,0 +1,171 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+// streamWriter records what a stream client writes
+type streamWriter struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.buf.Write(p)
+}
+
+func (w *streamWriter) Flush() {}
+
+func (w *streamWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.buf.String()
+}
+
+func newTestStream(t *testing.T, bufferSize int) (*StreamClient, *streamWriter) {
+	t.Helper()
+
+	cf, err := NewFactory(Config{
+		SendBufferSize:          bufferSize,
+		StreamHeartbeatInterval: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("failed to create a factory: %v", err)
+	}
+
+	w := &streamWriter{}
+
+	return cf.NewStream(w, StreamConfig{Types: []string{event.NewBlock}}), w
+}
+
+func blockEvent(seq uint64) *event.Event {
+	return &event.Event{
+		Type:    event.NewBlock,
+		Seq:     seq,
+		Payload: []byte(`{"seq":` + strconv.FormatUint(seq, 10) + `}`),
+	}
+}
+
+// pages returns a replay source returning each page once
+func pages(pages ...[]*event.Event) func() ([]*event.Event, error) {
+	return func() ([]*event.Event, error) {
+		if len(pages) == 0 {
+			return nil, nil
+		}
+
+		page := pages[0]
+		pages = pages[1:]
+
+		return page, nil
+	}
+}
+
+func TestStreamReplayWritesOnce(t *testing.T) {
+	c, w := newTestStream(t, 10)
+
+	err := c.Replay(pages(
+		[]*event.Event{blockEvent(1), blockEvent(2)},
+		[]*event.Event{blockEvent(3)},
+	))
+	if err != nil {
+		t.Fatalf("failed to replay: %v", err)
+	}
+
+	// live events received during the replay overlap with replayed ones
+	for _, seq := range []uint64{2, 3, 4} {
+		c.SendEvent(blockEvent(seq))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Serve(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(w.String(), "id: 4\n") && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	got := w.String()
+	for _, seq := range []string{"1", "2", "3", "4"} {
+		if n := strings.Count(got, "id: "+seq+"\n"); n != 1 {
+			t.Errorf("event %s was written %d times:\n%s", seq, n, got)
+		}
+	}
+
+	if i, j := strings.Index(got, "id: 3\n"), strings.Index(got, "id: 4\n"); i > j {
+		t.Errorf("events were written out of order:\n%s", got)
+	}
+}
+
+func TestStreamReplayDoesNotBlockLiveEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		live   int
+		closed bool
+	}{
+		{name: "buffered", live: 2, closed: false},
+		{name: "overflowed", live: 5, closed: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, _ := newTestStream(t, 2)
+
+			sent := make(chan struct{})
+			next := func() ([]*event.Event, error) {
+				select {
+				case <-sent:
+					return nil, nil
+				default:
+				}
+
+				// the hub sends live events while a page is replayed
+				go func() {
+					defer close(sent)
+
+					for i := 0; i < tc.live; i++ {
+						c.SendEvent(blockEvent(uint64(100 + i)))
+					}
+				}()
+
+				select {
+				case <-sent:
+				case <-time.After(5 * time.Second):
+					t.Fatal("sending live events blocked during a replay")
+				}
+
+				return []*event.Event{blockEvent(1)}, nil
+			}
+
+			if err := c.Replay(next); err != nil {
+				t.Fatalf("failed to replay: %v", err)
+			}
+
+			deadline := time.Now().Add(5 * time.Second)
+			for tc.closed && !c.IsClosed() && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if c.IsClosed() != tc.closed {
+				t.Fatalf("client is closed: %v, want %v", c.IsClosed(), tc.closed)
+			}
+		})
+	}
+}
@@ -1,8 +1,9 @@
 package client
 
 import (
-	"encoding/json"
 	"fmt"
+
+	"github.com/synycboom/algorand-notification/event"
 )
 
 func (c *Client) validateSubscribing(req Request) error {
@@ -20,17 +21,54 @@ func (c *Client) validateSubscribing(req Request) error {
 		}
 	}
 
+	if req.Batch && c.conf.BatchDisabled {
+		return fmt.Errorf("batch is not supported")
+	}
+
+	if len(req.Fields) > 0 {
+		if _, err := event.NewProjection(req.Fields); err != nil {
+			return fmt.Errorf("invalid fields")
+		}
+	}
+
 	return nil
 }
 
-func newSubscribingResponse(id uint64) ([]byte, error) {
-	bb, err := json.Marshal(Response{
-		ID:     id,
-		Result: nil,
-	})
-	if err != nil {
-		return nil, err
+// authorizeSubscribing checks the events of a request against the principal
+func (c *Client) authorizeSubscribing(req Request) error {
+	for _, event := range req.Params {
+		if !c.principal.Allows(event) {
+			return fmt.Errorf("event %s is not allowed", event)
+		}
+	}
+
+	return nil
+}
+
+// subscribe applies quotas and adds a subscription to the events of a request, it returns the subscription id
+func (c *Client) subscribe(req Request) (interface{}, *requestError) {
+	if err := c.limitRequest(req); err != nil {
+		return nil, newQuotaError(err)
+	}
+
+	if err := c.validateSubscribing(req); err != nil {
+		return nil, newRequestError(errInvalidParams, err.Error())
+	}
+
+	if err := c.authorizeSubscribing(req); err != nil {
+		return nil, newRequestError(errForbidden, err.Error())
+	}
+
+	s := c.newSubscription(req)
+	subs := c.withSubscription(s)
+	if err := c.limitSubscribing(subs); err != nil {
+		return nil, newQuotaError(err)
+	}
+
+	c.lastSubscriptionID++
+	if added, _ := c.setSubscriptions(subs); len(added) > 0 {
+		c.subscribeHandler(added)
 	}
 
-	return bb, nil
+	return s.id, nil
 }
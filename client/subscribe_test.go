@@ -0,0 +1,56 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// legacyResponse serves a legacy request and decodes its response
+func legacyResponse(t *testing.T, c *Client, req string) Response {
+	t.Helper()
+
+	bb, err := c.serveLegacy([]byte(req))
+	if err != nil {
+		t.Fatalf("failed to serve %s: %v", req, err)
+	}
+
+	var res Response
+	if err := json.Unmarshal(bb, &res); err != nil {
+		t.Fatalf("failed to decode the response %s: %v", bb, err)
+	}
+
+	return res
+}
+
+func TestSubscribeBatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		disabled bool
+		req      string
+		code     int
+	}{
+		{name: "batch", req: `{"id":1,"method":"SUBSCRIBE","params":["NEW_PAYMENT_TX"],"batch":true}`},
+		{name: "without batch when disabled", disabled: true, req: `{"id":1,"method":"SUBSCRIBE","params":["NEW_PAYMENT_TX"]}`},
+		{name: "batch when disabled", disabled: true, req: `{"id":1,"method":"SUBSCRIBE","params":["NEW_PAYMENT_TX"],"batch":true}`, code: 400},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(Config{BatchDisabled: tc.disabled}, Session{})
+			res := legacyResponse(t, c, tc.req)
+
+			code := 0
+			if res.Error != nil {
+				code = res.Error.Code
+			}
+
+			if code != tc.code {
+				t.Fatalf("responded with %+v, want the code %d", res.Error, tc.code)
+			}
+
+			if subscribed := len(c.activeSubscriptions()) > 0; subscribed != (tc.code == 0) {
+				t.Fatalf("subscribed %v", subscribed)
+			}
+		})
+	}
+}
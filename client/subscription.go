@@ -0,0 +1,183 @@
+package client
+
+import (
+	"strconv"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+// Subscription represents an active subscription listed by the LIST_SUBSCRIPTIONS method
+type Subscription struct {
+	ID     string   `json:"id"`
+	Events []string `json:"events"`
+	Fields []string `json:"fields,omitempty"`
+	Batch  bool     `json:"batch,omitempty"`
+}
+
+// subscription is a logical stream of events of a connection, it is not modified once it is active
+type subscription struct {
+	id         string
+	events     []string
+	fields     []string
+	projection *event.Projection
+	batch      bool
+}
+
+// has returns true if the subscription includes an event type
+func (s *subscription) has(eventType string) bool {
+	for _, t := range s.events {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// without returns a copy of the subscription without some event types, or the subscription itself if it has none of them
+func (s *subscription) without(types map[string]struct{}) *subscription {
+	var events []string
+	for _, t := range s.events {
+		if _, exist := types[t]; !exist {
+			events = append(events, t)
+		}
+	}
+
+	if len(events) == len(s.events) {
+		return s
+	}
+
+	c := *s
+	c.events = events
+
+	return &c
+}
+
+// tagged returns true if deliveries carry subscription ids, so that subscriptions of a connection may overlap
+func (c *Client) tagged() bool {
+	return c.jsonrpc || c.version != event.Version1
+}
+
+// tag returns a subscription id sent with deliveries of a subscription, it is empty if deliveries are not tagged
+func (c *Client) tag(s *subscription) string {
+	if !c.tagged() {
+		return ""
+	}
+
+	return s.id
+}
+
+// activeSubscriptions returns the current subscriptions, the slice is replaced rather than modified
+func (c *Client) activeSubscriptions() []*subscription {
+	c.subscriptionsMu.RLock()
+	defer c.subscriptionsMu.RUnlock()
+
+	return c.subscriptions
+}
+
+// setSubscriptions replaces the subscriptions and returns event types added to and removed from the connection
+func (c *Client) setSubscriptions(subs []*subscription) ([]string, []string) {
+	c.subscriptionsMu.Lock()
+	before := subscribedTypes(c.subscriptions)
+	c.subscriptions = subs
+	c.subscriptionsMu.Unlock()
+
+	after := subscribedTypes(subs)
+	var added, removed []string
+	for _, t := range event.AllEvents {
+		_, was := before[t]
+		_, is := after[t]
+		if is && !was {
+			added = append(added, t)
+		} else if was && !is {
+			removed = append(removed, t)
+		}
+	}
+
+	return added, removed
+}
+
+// newSubscription returns a subscription of a validated request with the next id
+func (c *Client) newSubscription(req Request) *subscription {
+	s := &subscription{
+		id:     strconv.FormatUint(c.lastSubscriptionID+1, 10),
+		fields: req.Fields,
+		batch:  req.Batch,
+	}
+
+	seen := make(map[string]struct{}, len(req.Params))
+	for _, t := range req.Params {
+		if _, exist := seen[t]; !exist {
+			seen[t] = struct{}{}
+			s.events = append(s.events, t)
+		}
+	}
+
+	// fields were validated, so the projection is only nil if no fields are given
+	s.projection, _ = event.NewProjection(req.Fields)
+
+	return s
+}
+
+// withSubscription returns the subscriptions after adding one.
+// Without tags, subscriptions of a connection cannot overlap, so the event types of the new one are moved out of the others.
+func (c *Client) withSubscription(s *subscription) []*subscription {
+	moved := make(map[string]struct{}, len(s.events))
+	for _, t := range s.events {
+		moved[t] = struct{}{}
+	}
+
+	subs := make([]*subscription, 0, len(c.subscriptions)+1)
+	for _, old := range c.subscriptions {
+		if !c.tagged() {
+			if old = old.without(moved); len(old.events) == 0 {
+				continue
+			}
+		}
+
+		subs = append(subs, old)
+	}
+
+	return append(subs, s)
+}
+
+// listSubscriptions returns the active subscriptions in the order they were created
+func (c *Client) listSubscriptions(req Request) (interface{}, *requestError) {
+	if err := c.limitRequest(req); err != nil {
+		return nil, newQuotaError(err)
+	}
+
+	list := make([]Subscription, 0, len(c.subscriptions))
+	for _, s := range c.subscriptions {
+		list = append(list, Subscription{
+			ID:     s.id,
+			Events: s.events,
+			Fields: s.fields,
+			Batch:  s.batch,
+		})
+	}
+
+	return list, nil
+}
+
+// subscribedTypes returns event types of at least one subscription
+func subscribedTypes(subs []*subscription) map[string]struct{} {
+	types := make(map[string]struct{})
+	for _, s := range subs {
+		for _, t := range s.events {
+			types[t] = struct{}{}
+		}
+	}
+
+	return types
+}
+
+// subscribedEvents returns the number of event types of subscriptions, a type is counted once per subscription
+func subscribedEvents(subs []*subscription) int {
+	n := 0
+	for _, s := range subs {
+		n += len(s.events)
+	}
+
+	return n
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+func TestSubscriptionIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		session  Session
+		requests []Request
+		// code is the error code of the last request
+		code int
+		want []Subscription
+	}{
+		{
+			name: "sequential ids",
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock}},
+				{Method: methodSubscribe, Params: []string{event.NewPaymentTx}, Batch: true},
+			},
+			want: []Subscription{
+				{ID: "1", Events: []string{event.NewBlock}},
+				{ID: "2", Events: []string{event.NewPaymentTx}, Batch: true},
+			},
+		},
+		{
+			name: "duplicated events",
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock, event.NewBlock}},
+			},
+			want: []Subscription{{ID: "1", Events: []string{event.NewBlock}}},
+		},
+		{
+			name: "untagged subscriptions do not overlap",
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock, event.NewPaymentTx}},
+				{Method: methodSubscribe, Params: []string{event.NewPaymentTx}, Fields: []string{"id"}},
+			},
+			want: []Subscription{
+				{ID: "1", Events: []string{event.NewBlock}},
+				{ID: "2", Events: []string{event.NewPaymentTx}, Fields: []string{"id"}},
+			},
+		},
+		{
+			name: "untagged subscription replaced",
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock}},
+				{Method: methodSubscribe, Params: []string{event.NewBlock}, Batch: true},
+			},
+			want: []Subscription{{ID: "2", Events: []string{event.NewBlock}, Batch: true}},
+		},
+		{
+			name:    "JSON-RPC subscriptions overlap",
+			session: Session{JSONRPC: true},
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock, event.NewPaymentTx}},
+				{Method: methodSubscribe, Params: []string{event.NewPaymentTx}},
+			},
+			want: []Subscription{
+				{ID: "1", Events: []string{event.NewBlock, event.NewPaymentTx}},
+				{ID: "2", Events: []string{event.NewPaymentTx}},
+			},
+		},
+		{
+			name:    "version 2 subscriptions overlap",
+			session: Session{Version: event.Version2},
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock}},
+				{Method: methodSubscribe, Params: []string{event.NewBlock}},
+			},
+			want: []Subscription{
+				{ID: "1", Events: []string{event.NewBlock}},
+				{ID: "2", Events: []string{event.NewBlock}},
+			},
+		},
+		{
+			name: "unsubscribe by id",
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock}},
+				{Method: methodSubscribe, Params: []string{event.NewPaymentTx}},
+				{Method: methodUnsubscribe, Params: []string{"1"}},
+				{Method: methodSubscribe, Params: []string{event.NewBlock}},
+			},
+			// ids are not reused
+			want: []Subscription{
+				{ID: "2", Events: []string{event.NewPaymentTx}},
+				{ID: "3", Events: []string{event.NewBlock}},
+			},
+		},
+		{
+			name:    "unsubscribe by type",
+			session: Session{JSONRPC: true},
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock, event.NewPaymentTx}},
+				{Method: methodSubscribe, Params: []string{event.NewPaymentTx}},
+				{Method: methodUnsubscribe, Params: []string{event.NewPaymentTx}},
+			},
+			want: []Subscription{{ID: "1", Events: []string{event.NewBlock}}},
+		},
+		{
+			name: "unsubscribe an unknown id",
+			requests: []Request{
+				{Method: methodSubscribe, Params: []string{event.NewBlock}},
+				{Method: methodUnsubscribe, Params: []string{"2"}},
+			},
+			code: 400,
+			want: []Subscription{{ID: "1", Events: []string{event.NewBlock}}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(Config{}, tc.session)
+
+			var rErr *requestError
+			for _, req := range tc.requests {
+				_, rErr, _ = c.serve(req)
+			}
+
+			code := 0
+			if rErr != nil {
+				code = rErr.code.legacy
+			}
+
+			if code != tc.code {
+				t.Fatalf("last request responded %v, want the code %d", rErr, tc.code)
+			}
+
+			list, _ := c.listSubscriptions(Request{Method: methodListSubscriptions})
+			if !reflect.DeepEqual(list, tc.want) {
+				t.Fatalf("got subscriptions %+v, want %+v", list, tc.want)
+			}
+		})
+	}
+}
+
+func TestSendEventTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		session Session
+		// tags are subscription ids expected in sent frames, empty if deliveries are not tagged
+		tags []string
+	}{
+		{name: "version 1", tags: []string{""}},
+		{name: "JSON-RPC", session: Session{JSONRPC: true}, tags: []string{`"subscription":"1"`, `"subscription":"2"`}},
+		{name: "version 2", session: Session{Version: event.Version2}, tags: []string{`"subscriptionId":"1"`, `"subscriptionId":"2"`}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(Config{}, tc.session)
+			for _, params := range [][]string{{event.NewBlock}, {event.NewBlock, event.NewPaymentTx}} {
+				if _, rErr := c.subscribe(Request{Method: methodSubscribe, Params: params}); rErr != nil {
+					t.Fatalf("failed to subscribe: %v", rErr)
+				}
+			}
+
+			c.SendEvent(blockEvent(1))
+
+			fs := frames(c)
+			if len(fs) != len(tc.tags) {
+				t.Fatalf("sent %d frames, want %d", len(fs), len(tc.tags))
+			}
+
+			for i, f := range fs {
+				data := string(f.data)
+				if tc.tags[i] == "" && strings.Contains(strings.ToLower(data), "subscription") {
+					t.Fatalf("untagged frame %s has a subscription id", data)
+				}
+
+				if !strings.Contains(data, tc.tags[i]) {
+					t.Fatalf("frame %s does not have %s", data, tc.tags[i])
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"encoding/json"
 	"fmt"
 )
 
@@ -10,8 +9,8 @@ func (c *Client) validateUnSubscribing(req Request) error {
 		return fmt.Errorf("invalid method")
 	}
 
-	for _, event := range req.Params {
-		if _, ok := validSubscriptionEvents[event]; !ok {
+	for _, param := range req.Params {
+		if _, ok := validSubscriptionEvents[param]; !ok && c.subscriptionByID(param) == nil {
 			return fmt.Errorf("invalid params")
 		}
 	}
@@ -19,14 +18,50 @@ func (c *Client) validateUnSubscribing(req Request) error {
 	return nil
 }
 
-func newUnsubscribingResponse(id uint64) ([]byte, error) {
-	bb, err := json.Marshal(Response{
-		ID:     id,
-		Result: nil,
-	})
-	if err != nil {
-		return nil, err
+func (c *Client) subscriptionByID(id string) *subscription {
+	for _, s := range c.subscriptions {
+		if s.id == id {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// unsubscribe applies quotas and removes subscriptions by their ids, or event types from every subscription
+func (c *Client) unsubscribe(req Request) (interface{}, *requestError) {
+	if err := c.limitRequest(req); err != nil {
+		return nil, newQuotaError(err)
+	}
+
+	if err := c.validateUnSubscribing(req); err != nil {
+		return nil, newRequestError(errInvalidParams, err.Error())
+	}
+
+	ids := make(map[string]struct{})
+	types := make(map[string]struct{})
+	for _, param := range req.Params {
+		if _, ok := validSubscriptionEvents[param]; ok {
+			types[param] = struct{}{}
+		} else {
+			ids[param] = struct{}{}
+		}
+	}
+
+	var subs []*subscription
+	for _, s := range c.subscriptions {
+		if _, exist := ids[s.id]; exist {
+			continue
+		}
+
+		if s = s.without(types); len(s.events) > 0 {
+			subs = append(subs, s)
+		}
+	}
+
+	if _, removed := c.setSubscriptions(subs); len(removed) > 0 {
+		c.unsubscribeHandler(removed)
 	}
 
-	return bb, nil
+	return nil, nil
 }
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/synycboom/algorand-notification/config"
+)
+
+var (
+	configFile string
+
+	Command = &cobra.Command{
+		Use:   "config",
+		Short: "manage configuration",
+		Long:  "manage configuration files of the server, monitor and standalone commands.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	validateCommand = &cobra.Command{
+		Use:       "validate [server|monitor|standalone]",
+		Short:     "validate a configuration file",
+		Long:      "validate a configuration file and report every invalid field.",
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: []string{"server", "monitor", "standalone"},
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := validate(args[0]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+
+			fmt.Printf("%s is valid\n", configFile)
+		},
+	}
+)
+
+func init() {
+	flags := validateCommand.Flags()
+	flags.StringVarP(&configFile, "config", "c", "", "file path to configuration file (server.yml, monitor.yml or standalone.yml)")
+
+	if err := validateCommand.MarkFlagRequired("config"); err != nil {
+		os.Exit(1)
+	}
+
+	Command.AddCommand(validateCommand)
+}
+
+func validate(kind string) error {
+	switch kind {
+	case "monitor":
+		_, err := config.LoadMonitor(configFile, nil)
+
+		return err
+	case "standalone":
+		_, err := config.LoadStandalone(configFile, nil)
+
+		return err
+	}
+
+	_, err := config.LoadServer(configFile, nil)
+
+	return err
+}
@@ -0,0 +1,247 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/config"
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/fetcher"
+	"github.com/synycboom/algorand-notification/health"
+)
+
+// Publisher publishes serialized blocks and events
+type Publisher interface {
+	// Publish publishes a block to the block channel
+	Publish(ctx context.Context, message []byte) error
+
+	// PublishTo publishes a message to a channel
+	PublishTo(ctx context.Context, channel string, message []byte) error
+}
+
+// BlockPublisher is implemented by publishers that take blocks as they are, without serializing them
+type BlockPublisher interface {
+	// PublishBlock publishes a block to the block channel
+	PublishBlock(ctx context.Context, b *models.Block) error
+}
+
+// Checkpoint persists the last published round
+type Checkpoint interface {
+	// Load returns the saved round, it returns false if nothing was saved yet
+	Load() (uint64, bool, error)
+
+	// Save saves a round
+	Save(round uint64) error
+}
+
+// App wires the fetcher and the checkpoint of the monitor command, so that the standalone command can reuse them
+type App struct {
+	conf       *config.Monitor
+	publisher  Publisher
+	fetcher    *fetcher.Fetcher
+	checkpoint Checkpoint
+	routes     *routes
+}
+
+// NewApp creates a fetcher that publishes every block and saves it to the checkpoint.
+// It resumes after the saved round if there is one, cp may be nil.
+// r is only used when publish_mode includes routed, it may be nil otherwise.
+func NewApp(conf *config.Monitor, p Publisher, cp Checkpoint, r Registry) (*App, error) {
+	a := &App{
+		conf:       conf,
+		publisher:  p,
+		checkpoint: cp,
+	}
+
+	if conf.Publishes(config.ModeRouted) {
+		if r == nil {
+			return nil, errors.New("monitor: an interest registry is required in the routed mode")
+		}
+
+		a.routes = &routes{
+			registry: r,
+			refresh:  conf.InterestRefreshInterval,
+		}
+	}
+
+	startRound := conf.Round()
+	if cp != nil {
+		round, exist, err := cp.Load()
+		if err != nil {
+			return nil, err
+		}
+
+		if exist {
+			log.Info().Msgf("monitor: resuming after the checkpoint round %v", round)
+			startRound = &round
+		}
+	}
+
+	var err error
+	a.fetcher, err = fetcher.New(fetcher.Config{
+		Host:       conf.IndexerHost,
+		APIToken:   conf.IndexerAPIToken,
+		RPS:        conf.FetcherRPS,
+		StartRound: startRound,
+		Processor: func(b *models.Block) {
+			ctx, cancel := context.WithTimeout(context.Background(), conf.PublisherTimeout)
+			defer cancel()
+
+			if err := a.publish(ctx, b); err != nil {
+				log.Error().Err(err).Msg("monitor: failed to publish a block")
+
+				return
+			}
+
+			if cp != nil {
+				if err := cp.Save(b.Round); err != nil {
+					log.Error().Err(err).Msg("monitor: failed to save the checkpoint")
+				}
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// publish publishes a block and its events according to the publish mode
+func (a *App) publish(ctx context.Context, b *models.Block) error {
+	if bp, ok := a.publisher.(BlockPublisher); ok && a.conf.Publishes(config.ModeBlock) {
+		if err := bp.PublishBlock(ctx, b); err != nil {
+			return err
+		}
+	} else if a.conf.Publishes(config.ModeBlock) {
+		message, err := json.Marshal(b)
+		if err != nil {
+			return err
+		}
+
+		if err := a.publisher.Publish(ctx, message); err != nil {
+			return err
+		}
+	}
+
+	if !a.conf.Publishes(config.ModeEvents) && a.routes == nil {
+		return nil
+	}
+
+	// events are parsed once here instead of in every server
+	events, err := event.FromBlock(b)
+	if err != nil {
+		return err
+	}
+
+	if a.routes != nil {
+		if err := a.route(ctx, b.Round, events); err != nil {
+			return err
+		}
+	}
+
+	if !a.conf.Publishes(config.ModeEvents) {
+		return nil
+	}
+
+	channels, groups := event.GroupByChannel(a.conf.EventChannelPrefix, events, a.conf.EventChannelsByID)
+	for _, channel := range channels {
+		message, err := event.MarshalBatch(b.Round, groups[channel])
+		if err != nil {
+			return err
+		}
+
+		if err := a.publisher.PublishTo(ctx, channel, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Start starts fetching blocks
+func (a *App) Start() {
+	a.fetcher.Start()
+}
+
+// Stop stops fetching blocks immediately
+func (a *App) Stop() {
+	a.fetcher.Stop()
+}
+
+// Apply applies reloadable fields of a reloaded configuration
+func (a *App) Apply(next *config.Monitor) error {
+	if logLevel, err := zerolog.ParseLevel(next.LogLevel); err == nil {
+		zerolog.SetGlobalLevel(logLevel)
+	}
+
+	a.fetcher.SetRPS(next.FetcherRPS)
+
+	return nil
+}
+
+// ProcessedRound returns the last published round
+func (a *App) ProcessedRound() uint64 {
+	return a.fetcher.ProcessedRound()
+}
+
+// Checks returns readiness checks of the indexer and the lag
+func (a *App) Checks() []health.Check {
+	return []health.Check{
+		{
+			Name:  "indexer",
+			Check: a.CheckIndexer,
+		},
+		{
+			Name:  "lag",
+			Check: a.CheckLag,
+		},
+	}
+}
+
+// CheckIndexer returns an error if the indexer is unreachable
+func (a *App) CheckIndexer(ctx context.Context) error {
+	_, err := a.fetcher.LatestRound(ctx)
+
+	return err
+}
+
+// CheckLag returns an error if the published round is too far behind the indexer
+func (a *App) CheckLag(ctx context.Context) error {
+	latest, err := a.fetcher.LatestRound(ctx)
+	if err != nil {
+		return err
+	}
+
+	if processed := a.fetcher.ProcessedRound(); latest > processed && latest-processed > a.conf.ReadinessMaxLag {
+		return fmt.Errorf("%d rounds behind the latest round %d", latest-processed, latest)
+	}
+
+	return nil
+}
+
+// Drain publishes queued blocks and saves the checkpoint
+func (a *App) Drain(ctx context.Context) error {
+	err := a.fetcher.Drain(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("monitor: failed to process queued blocks before the deadline")
+	}
+
+	if a.checkpoint != nil {
+		if err := a.checkpoint.Save(a.fetcher.ProcessedRound()); err != nil {
+			log.Error().Err(err).Msg("monitor: failed to save the checkpoint")
+
+			return err
+		}
+
+		log.Info().Msgf("monitor: saved the checkpoint round %v", a.fetcher.ProcessedRound())
+	}
+
+	return err
+}
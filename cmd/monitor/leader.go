@@ -0,0 +1,117 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/checkpoint"
+	"github.com/synycboom/algorand-notification/config"
+	"github.com/synycboom/algorand-notification/health"
+	"github.com/synycboom/algorand-notification/publisher"
+)
+
+// leader runs an app only while the instance holds the leadership, standbys keep their checks passing
+type leader struct {
+	publisher  *publisher.RedisPublisher
+	checkpoint *checkpoint.Redis
+	registry   Registry
+
+	mu   sync.RWMutex
+	conf *config.Monitor
+	app  *App
+}
+
+// Lead fetches and publishes from the shared checkpoint until the context is done, then drains the fetcher.
+// Publishes and checkpoints are fenced by the token, so they are rejected once another instance takes over.
+func (l *leader) Lead(ctx context.Context, token uint64) {
+	l.mu.Lock()
+	conf := *l.conf
+	app, err := NewApp(&conf, l.publisher.Fenced(token), l.checkpoint.Fenced(token), l.registry)
+	if err != nil {
+		l.mu.Unlock()
+		log.Error().Err(err).Msg("monitor: failed to start fetching as the leader")
+
+		return
+	}
+
+	app.Start()
+	l.app = app
+	l.mu.Unlock()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), conf.ShutdownTimeout)
+	defer cancel()
+
+	_ = app.Drain(shutdownCtx)
+	app.Stop()
+
+	l.mu.Lock()
+	l.app = nil
+	l.mu.Unlock()
+}
+
+// Apply applies reloadable fields to the current term and the next ones
+func (l *leader) Apply(next *config.Monitor) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.conf = next
+	if l.app != nil {
+		return l.app.Apply(next)
+	}
+
+	if logLevel, err := zerolog.ParseLevel(next.LogLevel); err == nil {
+		zerolog.SetGlobalLevel(logLevel)
+	}
+
+	return nil
+}
+
+// ProcessedRound returns the last published round, it is 0 for a standby
+func (l *leader) ProcessedRound() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.app == nil {
+		return 0
+	}
+
+	return l.app.ProcessedRound()
+}
+
+// Checks returns readiness checks of the indexer and the lag, which always pass for a standby
+func (l *leader) Checks() []health.Check {
+	return []health.Check{
+		{
+			Name: "indexer",
+			Check: func(ctx context.Context) error {
+				if app := l.current(); app != nil {
+					return app.CheckIndexer(ctx)
+				}
+
+				return nil
+			},
+		},
+		{
+			Name: "lag",
+			Check: func(ctx context.Context) error {
+				if app := l.current(); app != nil {
+					return app.CheckLag(ctx)
+				}
+
+				return nil
+			},
+		},
+	}
+}
+
+func (l *leader) current() *App {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.app
+}
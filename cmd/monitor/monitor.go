@@ -2,18 +2,24 @@ package monitor
 
 import (
 	"context"
-	"encoding/json"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
-	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
 	"github.com/labstack/echo-contrib/prometheus"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
-
-	"github.com/synycboom/algorand-notification/fetcher"
+	"github.com/spf13/pflag"
+
+	"github.com/synycboom/algorand-notification/checkpoint"
+	"github.com/synycboom/algorand-notification/config"
+	"github.com/synycboom/algorand-notification/election"
+	"github.com/synycboom/algorand-notification/health"
+	"github.com/synycboom/algorand-notification/interest"
+	"github.com/synycboom/algorand-notification/metrics"
 	"github.com/synycboom/algorand-notification/publisher"
 )
 
@@ -25,7 +31,7 @@ var (
 		Short: "run monitor daemon",
 		Long:  "run monitor daemon that watches new blocks and publishes events.",
 		Run: func(cmd *cobra.Command, args []string) {
-			if err := run(); err != nil {
+			if err := run(cmd.Flags()); err != nil {
 				log.Error().Err(err).Msg("daemon: unexpected error")
 				os.Exit(1)
 			}
@@ -36,82 +42,173 @@ var (
 func init() {
 	flags := Command.Flags()
 	flags.StringVarP(&configFile, "config", "c", "", "file path to configuration file (monitor.yml)")
+	config.MonitorFlags(flags)
 
 	if err := Command.MarkFlagRequired("config"); err != nil {
 		os.Exit(1)
 	}
 }
 
-func run() error {
-	viper.SetConfigType("yaml")
-	viper.SetConfigFile(configFile)
-	if err := viper.ReadInConfig(); err != nil {
+func run(flags *pflag.FlagSet) error {
+	conf, err := config.LoadMonitor(configFile, flags)
+	if err != nil {
 		return err
 	}
 
-	log.Info().Msgf("server: using config file %s", viper.ConfigFileUsed())
-
-	indexerHost := viper.GetString("INDEXER_HOST")
-	indexerAPIToken := viper.GetString("INDEXER_API_TOKEN")
-	fetcherRPS := viper.GetInt("FETCHER_RPS")
-	metricsPort := viper.GetString("METRICS_PORT")
-	redisHost := viper.GetString("REDIS_HOST")
-	redisPassword := viper.GetString("REDIS_PASSWORD")
-	publishTimeout := viper.GetDuration("PUBLISHER_TIMEOUT")
-	channel := viper.GetString("NEW_BLOCK_CHANNEL")
-	var startRound *uint64 = nil
-	if viper.GetString("START_ROUND") != "latest" {
-		value := viper.GetUint64("START_ROUND")
-		startRound = &value
-	}
+	log.Info().Msgf("server: using config file %s", configFile)
 
-	logLevel, err := zerolog.ParseLevel(viper.GetString("LOG_LEVEL"))
+	logLevel, err := zerolog.ParseLevel(conf.LogLevel)
 	if err == nil {
 		zerolog.SetGlobalLevel(logLevel)
 	}
 
 	p, err := publisher.NewRedis(publisher.RedisConfig{
-		RedisHost:     redisHost,
-		RedisPassword: redisPassword,
-		Channel:       channel,
+		RedisHost:     conf.RedisHost,
+		RedisPassword: conf.RedisPassword,
+		Channel:       conf.NewBlockChannel,
+		FenceKey:      election.FenceKey(conf.LeaderElectionKey),
 	})
 	if err != nil {
 		return err
 	}
+	defer p.Close()
+
+	metrics.RegisterMonitorMetrics()
+
+	// the registry stays nil unless events are routed, so that the app can tell it is not configured
+	var registry Registry
+	if conf.Publishes(config.ModeRouted) {
+		r, err := interest.NewRedis(interest.RedisConfig{
+			RedisHost:     conf.RedisHost,
+			RedisPassword: conf.RedisPassword,
+			Prefix:        conf.InterestKeyPrefix,
+		})
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		registry = r
+	}
 
-	f, err := fetcher.New(fetcher.Config{
-		Host:       indexerHost,
-		APIToken:   indexerAPIToken,
-		RPS:        fetcherRPS,
-		StartRound: startRound,
-		Processor: func(b *models.Block) {
-			ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
-			defer cancel()
-
-			message, err := json.Marshal(b)
-			if err != nil {
-				log.Error().Err(err).Msg("monitor: failed to marshal json")
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var checks []health.Check
+	var round func() uint64
+	var apply func(*config.Monitor) error
+	var shutdown func(ctx context.Context)
+	if conf.LeaderElectionEnabled {
+		cp, err := checkpoint.NewRedis(checkpoint.RedisConfig{
+			RedisHost:     conf.RedisHost,
+			RedisPassword: conf.RedisPassword,
+			Key:           conf.CheckpointKey,
+			FenceKey:      election.FenceKey(conf.LeaderElectionKey),
+			Timeout:       conf.PublisherTimeout,
+		})
+		if err != nil {
+			return err
+		}
+		defer cp.Close()
+
+		e, err := election.NewRedis(election.RedisConfig{
+			RedisHost:     conf.RedisHost,
+			RedisPassword: conf.RedisPassword,
+			Key:           conf.LeaderElectionKey,
+			TTL:           conf.LeaderElectionTTL,
+			RenewInterval: conf.LeaderElectionRenewInterval,
+		})
+		if err != nil {
+			return err
+		}
+		defer e.Close()
+
+		l := &leader{
+			publisher:  p,
+			checkpoint: cp,
+			registry:   registry,
+			conf:       conf,
+		}
+
+		electionDone := make(chan struct{})
+		go func() {
+			defer close(electionDone)
+
+			e.Run(ctx, l.Lead)
+		}()
+
+		checks = l.Checks()
+		round = l.ProcessedRound
+		apply = l.Apply
+		shutdown = func(ctx context.Context) {
+			// the leader drains its fetcher and saves the checkpoint before releasing the lease
+			select {
+			case <-electionDone:
+			case <-ctx.Done():
+				log.Error().Msg("monitor: failed to step down before the deadline")
 			}
+		}
+	} else {
+		var cp Checkpoint
+		if conf.CheckpointFile != "" {
+			cp = checkpoint.NewFile(conf.CheckpointFile)
+		}
+
+		app, err := NewApp(conf, p, cp, registry)
+		if err != nil {
+			return err
+		}
+
+		app.Start()
+		defer app.Stop()
+
+		checks = app.Checks()
+		round = app.ProcessedRound
+		apply = app.Apply
+		shutdown = func(ctx context.Context) {
+			_ = app.Drain(ctx)
+		}
+	}
 
-			if err := p.Publish(ctx, message); err != nil {
-				log.Error().Err(err).Msg("monitor: failed to publish a block")
-			}
-		},
-	})
+	watcher, err := config.WatchMonitor(configFile, flags, *conf, apply)
 	if err != nil {
 		return err
 	}
-
-	f.Start()
-	defer f.Stop()
+	defer watcher.Close()
 
 	echoPrometheus := echo.New()
 	echoPrometheus.HideBanner = true
 	prom := prometheus.NewPrometheus("echo", nil)
 	prom.SetMetricsPath(echoPrometheus)
-	if err := echoPrometheus.Start(":" + metricsPort); err != nil {
+
+	health.New(health.Config{
+		Checks: append(checks, health.Check{
+			Name:  "redis",
+			Check: p.Ping,
+		}),
+		Round:   round,
+		Timeout: conf.ReadinessTimeout,
+	}).Register(echoPrometheus)
+
+	errChan := make(chan error, 1)
+	go func() {
+		if err := echoPrometheus.Start(":" + conf.MetricsPort); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+
+	select {
+	case err := <-errChan:
 		return err
+	case <-ctx.Done():
 	}
 
-	return nil
+	log.Info().Msg("monitor: shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), conf.ShutdownTimeout)
+	defer cancel()
+
+	shutdown(shutdownCtx)
+
+	return echoPrometheus.Shutdown(shutdownCtx)
 }
@@ -0,0 +1,86 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/interest"
+	"github.com/synycboom/algorand-notification/metrics"
+)
+
+// Registry loads the interest advertised by server replicas
+type Registry interface {
+	// Load returns the interest of every replica that advertised it within its ttl
+	Load(ctx context.Context) (map[string]interest.Interest, error)
+}
+
+// routes caches compiled interest of server replicas, so that the registry is not loaded for every round.
+// It is only used by the fetcher processor, so it is not safe for concurrent use.
+type routes struct {
+	registry Registry
+	refresh  time.Duration
+	loadedAt time.Time
+	matchers map[string]*interest.Matcher
+}
+
+// get returns matchers of every replica, the previous ones are kept if the registry cannot be loaded
+func (r *routes) get(ctx context.Context) map[string]*interest.Matcher {
+	if r.matchers != nil && time.Since(r.loadedAt) < r.refresh {
+		return r.matchers
+	}
+
+	interests, err := r.registry.Load(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("monitor: failed to load the interest of replicas")
+
+		return r.matchers
+	}
+
+	matchers := make(map[string]*interest.Matcher, len(interests))
+	for replica, i := range interests {
+		matchers[replica] = interest.Compile(i)
+	}
+
+	r.matchers = matchers
+	r.loadedAt = time.Now()
+	metrics.RoutedReplicas.Set(float64(len(matchers)))
+
+	return matchers
+}
+
+// route publishes events of a round to the channel of every replica, keeping only the ones matching its interest.
+// A replica receives a batch even if nothing matches, so that it can still tell skipped rounds.
+func (a *App) route(ctx context.Context, round uint64, events []*event.Event) error {
+	full, err := event.MarshalBatch(round, events)
+	if err != nil {
+		return err
+	}
+
+	for replica, m := range a.routes.get(ctx) {
+		var matched []*event.Event
+		for _, e := range events {
+			if m.Matches(e) {
+				matched = append(matched, e)
+			}
+		}
+
+		message := full
+		if len(matched) < len(events) {
+			message, err = event.MarshalBatch(round, matched)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := a.publisher.PublishTo(ctx, event.ReplicaChannel(a.conf.EventChannelPrefix, replica), message); err != nil {
+			return err
+		}
+
+		metrics.ObserveRouted(len(message), len(full))
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/interest"
+)
+
+// Registry represents a contract for advertising the interest of a replica
+type Registry interface {
+	// Advertise saves the interest of a replica for the ttl
+	Advertise(ctx context.Context, replica string, i interest.Interest, ttl time.Duration) error
+
+	// Withdraw deletes the interest of a replica
+	Withdraw(ctx context.Context, replica string) error
+}
+
+// advertiser keeps the interest of the app advertised, so that the monitor routes matching events to the replica
+type advertiser struct {
+	app        *App
+	registry   Registry
+	replica    string
+	ttl        time.Duration
+	notifyChan chan struct{}
+}
+
+// newAdvertiser creates an advertiser, it must be created before the app runs
+func newAdvertiser(app *App, registry Registry, replica string, ttl time.Duration) *advertiser {
+	a := &advertiser{
+		app:        app,
+		registry:   registry,
+		replica:    replica,
+		ttl:        ttl,
+		notifyChan: make(chan struct{}, 1),
+	}
+	app.OnInterest(func() {
+		select {
+		case a.notifyChan <- struct{}{}:
+		default:
+		}
+	})
+
+	return a
+}
+
+// Run advertises the interest whenever it changes and before it expires until the context is done
+func (a *advertiser) Run(ctx context.Context) {
+	// webhooks added through the API do not notify the advertiser, they are picked up on refresh
+	ticker := time.NewTicker(a.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		advertiseCtx, cancel := context.WithTimeout(ctx, a.ttl/3)
+		if err := a.registry.Advertise(advertiseCtx, a.replica, a.app.Interest(), a.ttl); err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("server: failed to advertise the interest")
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-a.notifyChan:
+		case <-ticker.C:
+		}
+	}
+}
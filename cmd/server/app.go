@@ -0,0 +1,476 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo-contrib/prometheus"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/client"
+	"github.com/synycboom/algorand-notification/config"
+	"github.com/synycboom/algorand-notification/dedupe"
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/handler"
+	"github.com/synycboom/algorand-notification/health"
+	"github.com/synycboom/algorand-notification/hub"
+	"github.com/synycboom/algorand-notification/interest"
+	"github.com/synycboom/algorand-notification/metrics"
+	"github.com/synycboom/algorand-notification/origin"
+	notificationv1 "github.com/synycboom/algorand-notification/proto/notification/v1"
+	"github.com/synycboom/algorand-notification/quota"
+	"github.com/synycboom/algorand-notification/store"
+	"github.com/synycboom/algorand-notification/tlsconfig"
+	"github.com/synycboom/algorand-notification/webhook"
+)
+
+// App wires the hub, the handlers and the servers of the server command, so that the standalone command can reuse them
+type App struct {
+	conf           *config.Server
+	hub            *hub.Hub
+	store          *store.MemoryStore
+	webhooks       *webhook.Dispatcher
+	authenticator  *auth.Authenticator
+	quota          *quota.Limiter
+	allowlist      *origin.Allowlist
+	rounds         *dedupe.Tracker
+	tlsReloader    *tlsconfig.Reloader
+	grpcServer     *grpc.Server
+	echoMainServer *echo.Echo
+	echoPrometheus *echo.Echo
+}
+
+// NewApp creates the server components, Close must be called to release them
+func NewApp(conf *config.Server) (*App, error) {
+	a := &App{
+		conf: conf,
+	}
+
+	var err error
+	a.hub, err = hub.New(conf.HubWorkerPoolSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.AuthEnabled {
+		a.authenticator, err = auth.New(authConfig(conf))
+		if err != nil {
+			a.Close()
+
+			return nil, err
+		}
+	}
+
+	a.quota = quota.New(quotaConfig(conf))
+	if conf.DedupeEnabled {
+		a.rounds = dedupe.New(conf.DedupeReorderTolerance)
+	}
+
+	clientConfig := client.Config{
+		WriteWaitTimeout:   conf.WriteWaitTimeout,
+		PongWaitTimeout:    conf.PongWaitTimeout,
+		PingInterval:       conf.PingInterval,
+		MaxReadMessageSize: conf.MaxReadMessageSize,
+		SendBufferSize:     conf.SendBufferSize,
+		CompressionLevel:   conf.CompressionLevel,
+		CompressionMinSize: conf.CompressionMinSize,
+		CompressionEvents:  conf.CompressionEvents,
+
+		StreamHeartbeatInterval: conf.StreamHeartbeatInterval,
+		AuthTimeout:             conf.AuthTimeout,
+		Quota:                   a.quota,
+		// every event channel delivers its own part of a round, so a round cannot be batched
+		BatchDisabled: conf.SubscribeMode == config.ModeEvents,
+	}
+	if a.authenticator != nil {
+		clientConfig.Authenticator = a.authenticator
+	}
+
+	f, err := client.NewFactory(clientConfig)
+	if err != nil {
+		a.Close()
+
+		return nil, err
+	}
+
+	a.store, err = store.NewMemory(store.MemoryConfig{
+		Size: conf.EventStoreSize,
+	})
+	if err != nil {
+		a.Close()
+
+		return nil, err
+	}
+
+	if conf.WebhookEnabled {
+		a.webhooks, err = webhook.New(webhook.Config{
+			Subscriptions:  conf.WebhookSubscriptions,
+			MaxAttempts:    conf.WebhookMaxAttempts,
+			InitialBackoff: conf.WebhookInitialBackoff,
+			MaxBackoff:     conf.WebhookMaxBackoff,
+			Timeout:        conf.WebhookTimeout,
+			QueueSize:      conf.WebhookQueueSize,
+			DeadLetterSize: conf.WebhookDeadLetterSize,
+			AllowHTTP:      conf.WebhookAllowHTTP,
+
+			AllowPrivateTargets: conf.WebhookAllowPrivateTargets,
+		})
+		if err != nil {
+			a.Close()
+
+			return nil, err
+		}
+
+		metrics.RegisterWebhookMetrics()
+	}
+
+	if conf.TLSEnabled {
+		a.tlsReloader, err = tlsconfig.New(tlsconfig.Config{
+			CertFile:     conf.TLSCertFile,
+			KeyFile:      conf.TLSKeyFile,
+			ClientCAFile: conf.TLSClientCAFile,
+			ClientAuth:   conf.TLSClientAuth,
+		})
+		if err != nil {
+			a.Close()
+
+			return nil, err
+		}
+	}
+
+	// in the events and routed modes, the store only receives what local clients need, so its history is incomplete
+	history := conf.SubscribeMode == config.ModeBlock
+
+	a.allowlist = origin.New(conf.OriginAllowlist)
+	handlerConfig := handler.Config{
+		Hub: a.hub,
+		Upgrader: &websocket.Upgrader{
+			EnableCompression: conf.CompressionEnabled,
+			CheckOrigin:       a.allowlist.CheckOrigin,
+		},
+		ClientFactory: f,
+		Store:         a.store,
+		Quota:         a.quota,
+		Compression:   conf.CompressionEnabled,
+		Version:       conf.ProtocolVersion,
+		Replay:        history,
+
+		WebhookAPIToken: conf.WebhookAPIToken,
+	}
+	if a.webhooks != nil {
+		handlerConfig.Webhooks = a.webhooks
+	}
+	if a.authenticator != nil {
+		handlerConfig.Authenticator = a.authenticator
+	}
+	hnd := handler.New(handlerConfig)
+
+	var grpcOptions []grpc.ServerOption
+	if a.tlsReloader != nil {
+		grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(a.tlsReloader.TLSConfig())))
+	}
+
+	a.grpcServer = grpc.NewServer(grpcOptions...)
+	notificationv1.RegisterNotificationServiceServer(a.grpcServer, handler.NewGRPC(handlerConfig))
+
+	metrics.RegisterServerMetrics()
+
+	a.echoMainServer = echo.New()
+	a.echoMainServer.HideBanner = true
+	a.echoMainServer.Use(middleware.Logger())
+	a.echoMainServer.GET("/", hnd.Upgrade)
+	if history {
+		a.echoMainServer.GET("/v1/events", hnd.Events, hnd.Authenticate)
+		a.echoMainServer.GET("/v1/tx/:id", hnd.Tx, hnd.Authenticate)
+	}
+	a.echoMainServer.GET("/v1/rounds/latest", hnd.LatestRound, hnd.Authenticate)
+	a.echoMainServer.GET("/v1/stream", hnd.Stream)
+	if conf.WebhookEnabled && conf.WebhookAPIEnabled {
+		a.echoMainServer.GET("/v1/webhooks", hnd.ListWebhooks, hnd.AuthenticateWebhooks)
+		a.echoMainServer.POST("/v1/webhooks", hnd.CreateWebhook, hnd.AuthenticateWebhooks)
+		a.echoMainServer.DELETE("/v1/webhooks/:id", hnd.DeleteWebhook, hnd.AuthenticateWebhooks)
+		a.echoMainServer.GET("/v1/webhooks/dead-letters", hnd.WebhookDeadLetters, hnd.AuthenticateWebhooks)
+	}
+
+	a.echoPrometheus = echo.New()
+	a.echoPrometheus.HideBanner = true
+	prom := prometheus.NewPrometheus("echo", nil)
+
+	a.echoMainServer.Use(prom.HandlerFunc)
+	prom.SetMetricsPath(a.echoPrometheus)
+
+	return a, nil
+}
+
+// Process parses a message of a subscribed channel and delivers its events.
+// A message is a block in the block subscribe mode, or a batch of parsed events of a round in the events and routed modes.
+func (a *App) Process(channel string, data []byte) {
+	var events []*event.Event
+	var err error
+	blockChannel := true
+	if a.conf.SubscribeMode == config.ModeEvents || a.conf.SubscribeMode == config.ModeRouted {
+		var round uint64
+		round, events, err = event.UnmarshalBatch(data)
+		if err != nil {
+			log.Error().Err(err).Msg("server: failed to unmarshal events")
+
+			return
+		}
+
+		// skipped rounds can only be told on the block event channel, other types are not in every round.
+		// A replica channel receives a batch for every round.
+		blockChannel = a.conf.SubscribeMode == config.ModeRouted || channel == event.Channel(a.conf.EventChannelPrefix, event.NewBlock)
+		if !a.observe(channel, round, blockChannel) {
+			return
+		}
+	} else {
+		events, err = event.Parse(data)
+		if err != nil {
+			log.Error().Err(err).Msg("server: failed to parse an event")
+		}
+
+		if len(events) > 0 && !a.observe(channel, events[0].Round, blockChannel) {
+			return
+		}
+	}
+
+	a.deliver(events)
+}
+
+// ProcessEvents delivers events of a block parsed in the same process, it is used by the standalone command
+func (a *App) ProcessEvents(channel string, events []*event.Event) {
+	if len(events) > 0 && !a.observe(channel, events[0].Round, true) {
+		return
+	}
+
+	a.deliver(events)
+}
+
+// deliver stores events of a round and sends them to clients and webhooks
+func (a *App) deliver(events []*event.Event) {
+	a.store.Add(events)
+	a.hub.SendRound(events)
+
+	if a.webhooks != nil {
+		for _, event := range events {
+			a.webhooks.Dispatch(event)
+		}
+	}
+}
+
+// OnInterest sets a handler called whenever Interest may have changed, it must not block
+func (a *App) OnInterest(handler func()) {
+	a.hub.OnInterest(handler)
+}
+
+// Interest returns the interest of local clients, webhooks and event_channels_pinned
+func (a *App) Interest() interest.Interest {
+	i := make(interest.Interest)
+	for _, t := range a.conf.EventChannelsPinned {
+		i.Add(t, interest.Filter{})
+	}
+
+	i.Merge(a.hub.Interest())
+
+	if a.webhooks != nil {
+		for _, sub := range a.webhooks.List() {
+			for _, t := range sub.Events {
+				i.Add(t, interest.Filter{Address: sub.Address})
+			}
+		}
+	}
+
+	return i
+}
+
+// observe tells whether a round of a channel should be delivered, and reports skipped rounds if gaps is true
+func (a *App) observe(channel string, round uint64, gaps bool) bool {
+	if a.rounds == nil {
+		return true
+	}
+
+	res := a.rounds.Observe(channel, round)
+	if !res.Accepted {
+		metrics.ObserveDroppedRound(channel, res.Reason)
+		log.Warn().Msgf("server: dropped the %s round %v of %s", res.Reason, round, channel)
+
+		return false
+	}
+
+	if gaps && res.HasGap() {
+		metrics.ObserveRoundGap(channel, res.GapTo-res.GapFrom+1)
+		log.Warn().Msgf("server: skipped rounds %v to %v of %s", res.GapFrom, res.GapTo, channel)
+
+		if a.conf.DedupeGapMessageEnabled {
+			gap, err := event.NewGapDetected(res.GapFrom, res.GapTo)
+			if err != nil {
+				log.Error().Err(err).Msg("server: failed to create a gap detected event")
+
+				return true
+			}
+
+			a.hub.Broadcast(gap)
+		}
+	}
+
+	return true
+}
+
+// Apply applies reloadable fields of a reloaded configuration
+func (a *App) Apply(next *config.Server) error {
+	if a.authenticator != nil {
+		if err := a.authenticator.Reload(authConfig(next)); err != nil {
+			return err
+		}
+	}
+
+	if logLevel, err := zerolog.ParseLevel(next.LogLevel); err == nil {
+		zerolog.SetGlobalLevel(logLevel)
+	}
+
+	a.quota.SetConfig(quotaConfig(next))
+	a.allowlist.Set(next.OriginAllowlist)
+
+	return nil
+}
+
+// Run serves until the context is done or a server fails, then shuts down gracefully.
+// closeSource must stop delivering blocks to Process and wait for the one being processed.
+// checks are readiness checks of the block source.
+func (a *App) Run(ctx context.Context, closeSource func(ctx context.Context) error, checks ...health.Check) error {
+	health.New(health.Config{
+		Checks: append(checks, health.Check{
+			Name: "hub",
+			Check: func(ctx context.Context) error {
+				if a.hub.IsDraining() {
+					return errors.New("draining")
+				}
+
+				if !a.hub.IsRunning() {
+					return errors.New("not running")
+				}
+
+				return nil
+			},
+		}),
+		Round: func() uint64 {
+			round, _ := a.store.LatestRound()
+
+			return round
+		},
+		Timeout: a.conf.ReadinessTimeout,
+	}).Register(a.echoPrometheus)
+
+	go a.hub.Run()
+
+	errChan := make(chan error, 3)
+	go func() {
+		if err := a.echoPrometheus.Start(":" + a.conf.MetricsPort); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+
+	grpcListener, err := net.Listen("tcp", ":"+a.conf.GRPCPort)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := a.grpcServer.Serve(grpcListener); err != nil {
+			errChan <- err
+		}
+	}()
+
+	mainServer := &http.Server{
+		Addr: ":" + a.conf.Port,
+	}
+	if a.tlsReloader != nil {
+		mainServer.TLSConfig = a.tlsReloader.TLSConfig()
+	}
+
+	go func() {
+		if err := a.echoMainServer.StartServer(mainServer); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Info().Msg("server: shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.conf.ShutdownTimeout)
+	defer cancel()
+
+	// reject new connections, then deliver in-flight events before closing the existing ones
+	a.hub.Drain()
+	if err := closeSource(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("server: failed to close the block source")
+	}
+
+	if err := a.hub.Shutdown(shutdownCtx, a.conf.ShutdownReconnectAfter); err != nil {
+		log.Error().Err(err).Msg("server: failed to close all clients before the deadline")
+	}
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	select {
+	case <-grpcStopped:
+	case <-shutdownCtx.Done():
+		a.grpcServer.Stop()
+	}
+
+	if err := a.echoMainServer.Shutdown(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("server: failed to shut down the main server")
+	}
+
+	return a.echoPrometheus.Shutdown(shutdownCtx)
+}
+
+// Close releases the components
+func (a *App) Close() {
+	a.hub.Close()
+
+	if a.webhooks != nil {
+		a.webhooks.Close()
+	}
+
+	if a.tlsReloader != nil {
+		_ = a.tlsReloader.Close()
+	}
+}
+
+func authConfig(c *config.Server) auth.Config {
+	return auth.Config{
+		APIKeysFile:      c.AuthAPIKeysFile,
+		JWTAlgorithm:     c.AuthJWTAlgorithm,
+		JWTSecret:        c.AuthJWTSecret,
+		JWTPublicKeyFile: c.AuthJWTPublicKeyFile,
+	}
+}
+
+func quotaConfig(c *config.Server) quota.Config {
+	return quota.Config{
+		MaxConnectionsPerTenant:       c.QuotaMaxConnectionsPerTenant,
+		MaxSubscriptionsPerConnection: c.QuotaMaxSubscriptionsPerConnection,
+		MaxParamsPerRequest:           c.QuotaMaxParamsPerRequest,
+		RequestRate:                   c.QuotaRequestRate,
+		RequestBurst:                  c.QuotaRequestBurst,
+	}
+}
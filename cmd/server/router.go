@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+// routeRefreshInterval is an interval of refreshing subscribed channels,
+// since webhooks added through the API do not notify the router
+const routeRefreshInterval = 5 * time.Second
+
+// ChannelSetter represents a contract for subscribing a set of channels
+type ChannelSetter interface {
+	// SetChannels subscribes to the given channels and unsubscribes from the others
+	SetChannels(ctx context.Context, channels []string) error
+}
+
+// router keeps the subscribed event channels in line with the interest of the app
+type router struct {
+	app        *App
+	prefix     string
+	setter     ChannelSetter
+	notifyChan chan struct{}
+}
+
+// newRouter creates a router, it must be created before the app runs
+func newRouter(app *App, prefix string, setter ChannelSetter) *router {
+	r := &router{
+		app:        app,
+		prefix:     prefix,
+		setter:     setter,
+		notifyChan: make(chan struct{}, 1),
+	}
+	app.OnInterest(func() {
+		select {
+		case r.notifyChan <- struct{}{}:
+		default:
+		}
+	})
+
+	return r
+}
+
+// Run updates the subscribed channels whenever the interest changes until the context is done
+func (r *router) Run(ctx context.Context) {
+	ticker := time.NewTicker(routeRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		types := r.app.Interest().Types()
+		channels := make([]string, 0, len(types))
+		for _, t := range types {
+			channels = append(channels, event.Channel(r.prefix, t))
+		}
+		sort.Strings(channels)
+
+		setCtx, cancel := context.WithTimeout(ctx, routeRefreshInterval)
+		if err := r.setter.SetChannels(setCtx, channels); err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("server: failed to update subscribed channels")
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.notifyChan:
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,24 +1,21 @@
 package server
 
 import (
-	"net/http"
+	"context"
 	"os"
-	"time"
+	"os/signal"
+	"syscall"
 
-	"github.com/gorilla/websocket"
-	"github.com/labstack/echo-contrib/prometheus"
-	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
+	"github.com/spf13/pflag"
 
-	"github.com/synycboom/algorand-notification/client"
+	"github.com/synycboom/algorand-notification/config"
 	"github.com/synycboom/algorand-notification/event"
-	"github.com/synycboom/algorand-notification/handler"
-	"github.com/synycboom/algorand-notification/hub"
-	"github.com/synycboom/algorand-notification/metrics"
+	"github.com/synycboom/algorand-notification/health"
+	"github.com/synycboom/algorand-notification/instance"
+	"github.com/synycboom/algorand-notification/interest"
 	"github.com/synycboom/algorand-notification/subscriber"
 )
 
@@ -29,7 +26,7 @@ var (
 		Short: "run server",
 		Long:  "run server that subscribes events and accepts websocket connections.",
 		Run: func(cmd *cobra.Command, args []string) {
-			if err := run(); err != nil {
+			if err := run(cmd.Flags()); err != nil {
 				log.Error().Err(err).Msg("server: unexpected error")
 				os.Exit(1)
 			}
@@ -40,117 +37,105 @@ var (
 func init() {
 	flags := Command.Flags()
 	flags.StringVarP(&configFile, "config", "c", "", "file path to configuration file (server.yml)")
+	config.ServerFlags(flags)
 
 	if err := Command.MarkFlagRequired("config"); err != nil {
 		os.Exit(1)
 	}
 }
 
-func run() error {
-	viper.SetConfigType("yaml")
-	viper.SetConfigFile(configFile)
-	if err := viper.ReadInConfig(); err != nil {
+func run(flags *pflag.FlagSet) error {
+	conf, err := config.LoadServer(configFile, flags)
+	if err != nil {
 		return err
 	}
 
-	log.Info().Msgf("server: using config file %s", viper.ConfigFileUsed())
+	log.Info().Msgf("server: using config file %s", configFile)
 
-	port := viper.GetString("PORT")
-	metricsPort := viper.GetString("METRICS_PORT")
-	redisHost := viper.GetString("REDIS_HOST")
-	redisPassword := viper.GetString("REDIS_PASSWORD")
-	channel := viper.GetString("NEW_BLOCK_CHANNEL")
-	logLevel, err := zerolog.ParseLevel(viper.GetString("LOG_LEVEL"))
+	logLevel, err := zerolog.ParseLevel(conf.LogLevel)
 	if err == nil {
 		zerolog.SetGlobalLevel(logLevel)
 	}
 
-	h, err := hub.New(30000)
+	app, err := NewApp(conf)
 	if err != nil {
 		return err
 	}
-	defer h.Close()
+	defer app.Close()
+
+	// in the events mode, channels are subscribed by the router according to the interest of clients.
+	// In the routed mode, the monitor publishes events matching the advertised interest to the channel of the replica.
+	channel := conf.NewBlockChannel
+	var registry *interest.Redis
+	var replica string
+	switch conf.SubscribeMode {
+	case config.ModeEvents:
+		channel = ""
+	case config.ModeRouted:
+		replica = conf.ReplicaID
+		if replica == "" {
+			if replica, err = instance.ID(); err != nil {
+				return err
+			}
+		}
 
-	pingInterval, err := time.ParseDuration("3m")
-	if err != nil {
-		return err
-	}
+		registry, err = interest.NewRedis(interest.RedisConfig{
+			RedisHost:     conf.RedisHost,
+			RedisPassword: conf.RedisPassword,
+			Prefix:        conf.InterestKeyPrefix,
+		})
+		if err != nil {
+			return err
+		}
+		defer registry.Close()
 
-	pongWait, err := time.ParseDuration("3m15s")
-	if err != nil {
-		return err
+		channel = event.ReplicaChannel(conf.EventChannelPrefix, replica)
+		log.Info().Msgf("server: subscribing events routed to the replica %s", replica)
 	}
 
-	f, err := client.NewFactory(client.Config{
-		WriteWaitTimeout:   time.Duration(5) * time.Second,
-		PongWaitTimeout:    pongWait,
-		PingInterval:       pingInterval,
-		MaxReadMessageSize: 1024,
-		SendBufferSize:     100,
+	s, err := subscriber.NewRedis(&subscriber.RedisConfig{
+		RedisHost:     conf.RedisHost,
+		RedisPassword: conf.RedisPassword,
+		Channel:       channel,
+		Processor:     app.Process,
 	})
 	if err != nil {
-		return nil
+		return err
 	}
 
-	hnd := handler.New(handler.Config{
-		Hub: h,
-		Upgrader: &websocket.Upgrader{
-			EnableCompression: false,
-			CheckOrigin: func(r *http.Request) bool {
-				return true
-			},
-		},
-		ClientFactory: f,
-	})
-
-	metrics.RegisterServerMetrics()
-
-	echoMainServer := echo.New()
-	echoMainServer.HideBanner = true
-	echoMainServer.Use(middleware.Logger())
-	echoMainServer.GET("/", hnd.Upgrade)
-
-	echoPrometheus := echo.New()
-	echoPrometheus.HideBanner = true
-	prom := prometheus.NewPrometheus("echo", nil)
-
-	echoMainServer.Use(prom.HandlerFunc)
-	prom.SetMetricsPath(echoPrometheus)
-
-	s, err := subscriber.NewRedis(&subscriber.RedisConfig{
-		RedisHost:     redisHost,
-		RedisPassword: redisPassword,
-		Channel:       channel,
-		Processor: func(data []byte) {
-			events, err := event.Parse(data)
-			if err != nil {
-				log.Error().Err(err).Msg("server: failed to parse an event")
-			}
-
-			for _, event := range events {
-				h.SendEvent(event)
-			}
-		},
-	})
+	watcher, err := config.WatchServer(configFile, flags, *conf, app.Apply)
 	if err != nil {
+		_ = s.Close()
+
 		return err
 	}
-	defer func() {
-		if err := s.Close(); err != nil {
-			log.Error().Err(err).Msg("server: unexpected error")
-			os.Exit(1)
-		}
-	}()
+	defer watcher.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	checks := []health.Check{{Name: "subscriber", Check: s.Ping}}
+	switch conf.SubscribeMode {
+	case config.ModeEvents:
+		go newRouter(app, conf.EventChannelPrefix, s).Run(ctx)
+	case config.ModeRouted:
+		go newAdvertiser(app, registry, replica, conf.InterestTTL).Run(ctx)
+		checks = append(checks, health.Check{Name: "interest", Check: registry.Ping})
+	}
 
-	go h.Run()
-	go func() {
-		if err := echoPrometheus.Start(":" + metricsPort); err != nil {
-			log.Error().Err(err).Msg("server-metrics: unexpected error")
-			os.Exit(1)
+	closeSubscriber := func(ctx context.Context) error {
+		// the monitor stops routing events to the replica before its subscriber is closed
+		if registry != nil {
+			if err := registry.Withdraw(ctx, replica); err != nil {
+				log.Error().Err(err).Msg("server: failed to withdraw the interest")
+			}
 		}
-	}()
 
-	if err := echoMainServer.Start(":" + port); err != nil {
+		return s.Close()
+	}
+	if err := app.Run(ctx, closeSubscriber, checks...); err != nil {
+		_ = s.Close()
+
 		return err
 	}
 
@@ -0,0 +1,148 @@
+package standalone
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/synycboom/algorand-notification/checkpoint"
+	"github.com/synycboom/algorand-notification/cmd/monitor"
+	"github.com/synycboom/algorand-notification/cmd/server"
+	"github.com/synycboom/algorand-notification/config"
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/health"
+	"github.com/synycboom/algorand-notification/inproc"
+)
+
+var (
+	configFile string
+	Command    = &cobra.Command{
+		Use:   "standalone",
+		Short: "run monitor and server in a single process",
+		Long:  "run monitor and server in a single process that delivers new blocks in memory without Redis.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := run(cmd.Flags()); err != nil {
+				log.Error().Err(err).Msg("standalone: unexpected error")
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	flags := Command.Flags()
+	flags.StringVarP(&configFile, "config", "c", "", "file path to configuration file (standalone.yml)")
+	config.StandaloneFlags(flags)
+
+	if err := Command.MarkFlagRequired("config"); err != nil {
+		os.Exit(1)
+	}
+}
+
+func run(flags *pflag.FlagSet) error {
+	conf, err := config.LoadStandalone(configFile, flags)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Msgf("standalone: using config file %s", configFile)
+
+	logLevel, err := zerolog.ParseLevel(conf.Server.LogLevel)
+	if err == nil {
+		zerolog.SetGlobalLevel(logLevel)
+	}
+
+	serverApp, err := server.NewApp(&conf.Server)
+	if err != nil {
+		return err
+	}
+	defer serverApp.Close()
+
+	ch := inproc.New(inproc.Config[[]*event.Event]{
+		Size: conf.Server.SendBufferSize,
+		Processor: func(events []*event.Event) {
+			serverApp.ProcessEvents(conf.Server.NewBlockChannel, events)
+		},
+	})
+
+	var cp monitor.Checkpoint
+	if conf.Monitor.CheckpointFile != "" {
+		cp = checkpoint.NewFile(conf.Monitor.CheckpointFile)
+	}
+
+	monitorApp, err := monitor.NewApp(&conf.Monitor, blockPublisher{ch}, cp, nil)
+	if err != nil {
+		_ = ch.Close()
+
+		return err
+	}
+
+	monitorApp.Start()
+	defer monitorApp.Stop()
+
+	watcher, err := config.WatchStandalone(configFile, flags, *conf, func(next *config.Standalone) error {
+		if err := serverApp.Apply(&next.Server); err != nil {
+			return err
+		}
+
+		return monitorApp.Apply(&next.Monitor)
+	})
+	if err != nil {
+		_ = ch.Close()
+
+		return err
+	}
+	defer watcher.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// publish queued blocks and save the checkpoint, then deliver them to clients before closing the hub
+	closeSource := func(ctx context.Context) error {
+		_ = monitorApp.Drain(ctx)
+
+		return ch.Close()
+	}
+
+	checks := append(monitorApp.Checks(), health.Check{Name: "channel", Check: ch.Ping})
+	if err := serverApp.Run(ctx, closeSource, checks...); err != nil {
+		_ = ch.Close()
+
+		return err
+	}
+
+	return nil
+}
+
+// blockPublisher publishes events of blocks to the in-process channel, per-type channels are not used in standalone mode
+type blockPublisher struct {
+	ch *inproc.Channel[[]*event.Event]
+}
+
+// PublishBlock parses a block and passes its events to the server without serializing them
+func (p blockPublisher) PublishBlock(ctx context.Context, b *models.Block) error {
+	events, err := event.FromBlock(b)
+	if err != nil {
+		return err
+	}
+
+	return p.ch.Publish(ctx, events)
+}
+
+// Publish returns an error since blocks are published by PublishBlock
+func (p blockPublisher) Publish(ctx context.Context, message []byte) error {
+	return errors.New("standalone: serialized blocks are not supported")
+}
+
+// PublishTo returns an error since the standalone config only allows the block publish mode
+func (p blockPublisher) PublishTo(ctx context.Context, channel string, message []byte) error {
+	return errors.New("standalone: per-type channels are not supported")
+}
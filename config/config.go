@@ -0,0 +1,243 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	// EnvPrefix is a prefix of environment variables overriding config keys, e.g. ALGONOTIFY_REDIS_PASSWORD
+	EnvPrefix = "ALGONOTIFY"
+
+	// secretFileSuffix is a suffix of keys reading a secret from a file, e.g. redis_password_file
+	secretFileSuffix = "_file"
+
+	// ModeBlock publishes or subscribes raw blocks on new_block_channel
+	ModeBlock = "block"
+
+	// ModeEvents publishes or subscribes parsed events on per-type channels
+	ModeEvents = "events"
+
+	// ModeBoth publishes both raw blocks and parsed events, it is the same as block,events
+	ModeBoth = "both"
+
+	// ModeRouted publishes or subscribes parsed events on per-replica channels, according to the interest of each server replica
+	ModeRouted = "routed"
+
+	// DefaultEventChannelPrefix is a default prefix of per-type channels
+	DefaultEventChannelPrefix = "algorand-notification-event"
+
+	// DefaultInterestKeyPrefix is a default prefix of keys holding the interest of server replicas
+	DefaultInterestKeyPrefix = "algorand-notification-interest"
+)
+
+// FieldError describes an invalid configuration field
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError contains every invalid field of a configuration
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Error returns all invalid fields, one per line
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("config: %d invalid field(s)", len(e.Fields)))
+	for _, f := range e.Fields {
+		sb.WriteString(fmt.Sprintf("\n  - %s: %s", f.Field, f.Message))
+	}
+
+	return sb.String()
+}
+
+// validator collects field errors so that all of them can be reported at once
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) check(ok bool, field, message string) {
+	if ok {
+		return
+	}
+
+	// the standalone command validates keys shared by the server and the monitor twice
+	for _, f := range v.fields {
+		if f.Field == field && f.Message == message {
+			return
+		}
+	}
+
+	v.fields = append(v.fields, FieldError{Field: field, Message: message})
+}
+
+func (v *validator) port(value, field string) {
+	port, err := strconv.Atoi(value)
+	v.check(err == nil && port > 0 && port < 65536, field, "must be a port number between 1 and 65535")
+}
+
+func (v *validator) logLevel(value, field string) {
+	_, err := zerolog.ParseLevel(value)
+	v.check(err == nil, field, "must be one of trace, debug, info, warn, error, fatal, panic")
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Fields: v.fields}
+}
+
+// load reads a yaml file into out and returns the file followed by the secret files it read.
+// Flags take precedence over environment variables, which take precedence over the file, and keys missing everywhere take their defaults.
+func load(path string, flags *pflag.FlagSet, defaults map[string]interface{}, out interface{}) ([]string, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	v.SetConfigFile(path)
+	v.SetEnvPrefix(EnvPrefix)
+	for key, value := range defaults {
+		v.SetDefault(key, value)
+	}
+
+	fields := keys(reflect.TypeOf(out).Elem())
+	for _, f := range fields {
+		if err := v.BindEnv(f.key); err != nil {
+			return nil, err
+		}
+
+		if f.secret {
+			if err := v.BindEnv(f.key + secretFileSuffix); err != nil {
+				return nil, err
+			}
+		}
+
+		if flags == nil {
+			continue
+		}
+
+		for _, key := range f.flagKeys() {
+			if flag := flags.Lookup(flagName(key)); flag != nil {
+				if err := v.BindPFlag(key, flag); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	if err := v.Unmarshal(out); err != nil {
+		return nil, err
+	}
+
+	files, err := readSecretFiles(v, fields, reflect.ValueOf(out).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string{path}, files...), nil
+}
+
+// readSecretFiles replaces secrets with the content of <key>_file if it is set and returns the files it read
+func readSecretFiles(v *viper.Viper, fields []field, out reflect.Value) ([]string, error) {
+	var files []string
+	for _, f := range fields {
+		if !f.secret {
+			continue
+		}
+
+		path := v.GetString(f.key + secretFileSuffix)
+		if path == "" {
+			continue
+		}
+
+		bb, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("config: failed to read %s%s: %w", f.key, secretFileSuffix, err)
+		}
+
+		out.Field(f.index).SetString(strings.TrimRight(string(bb), "\r\n"))
+		files = append(files, path)
+	}
+
+	return files, nil
+}
+
+// field represents a config key of a struct field
+type field struct {
+	index  int
+	key    string
+	kind   reflect.Type
+	secret bool
+}
+
+// flagKeys returns keys settable by flags, secrets can also be set by a file
+func (f field) flagKeys() []string {
+	if f.secret {
+		return []string{f.key, f.key + secretFileSuffix}
+	}
+
+	return []string{f.key}
+}
+
+func keys(t reflect.Type) []field {
+	fields := make([]field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		key := sf.Tag.Get("mapstructure")
+
+		// lists of structs, e.g. webhook_subscriptions, can only be set in the file
+		if sf.Type.Kind() == reflect.Slice && sf.Type.Elem().Kind() == reflect.Struct {
+			continue
+		}
+
+		fields = append(fields, field{
+			index:  i,
+			key:    key,
+			kind:   sf.Type,
+			secret: sf.Tag.Get("secret") == "true",
+		})
+	}
+
+	return fields
+}
+
+func flagName(key string) string {
+	return strings.ReplaceAll(key, "_", "-")
+}
+
+// addFlags registers a flag for every config key of a struct, flags are only applied when they are set
+func addFlags(flags *pflag.FlagSet, t reflect.Type) {
+	for _, f := range keys(t) {
+		// keys shared by the server and the monitor are registered once for the standalone command
+		if flags.Lookup(flagName(f.key)) != nil {
+			continue
+		}
+
+		usage := "overrides " + f.key
+		switch {
+		case f.kind.Kind() == reflect.Bool:
+			flags.Bool(flagName(f.key), false, usage)
+		case f.kind.Kind() == reflect.Slice:
+			flags.StringSlice(flagName(f.key), nil, usage+" (comma separated)")
+		default:
+			flags.String(flagName(f.key), "", usage)
+		}
+
+		if f.secret {
+			flags.String(flagName(f.key+secretFileSuffix), "", "reads "+f.key+" from a file")
+		}
+	}
+}
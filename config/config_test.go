@@ -0,0 +1,192 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+
+	return path
+}
+
+func TestLoadServerOverrides(t *testing.T) {
+	secret := writeFile(t, "redis_password", "from-file\n")
+	other := writeFile(t, "other_password", "from-flag-file")
+
+	tests := []struct {
+		name  string
+		env   map[string]string
+		flags []string
+		check func(t *testing.T, c *Server)
+	}{
+		{
+			name: "file",
+			check: func(t *testing.T, c *Server) {
+				if c.Port != "8080" || c.RedisPassword != "password" || c.SendBufferSize != 100 || c.ShutdownTimeout != 30*time.Second {
+					t.Fatalf("unexpected config %+v", c)
+				}
+			},
+		},
+		{
+			name: "environment variables",
+			env: map[string]string{
+				"ALGONOTIFY_PORT":             "9000",
+				"ALGONOTIFY_SEND_BUFFER_SIZE": "7",
+				"ALGONOTIFY_SHUTDOWN_TIMEOUT": "1m",
+				"ALGONOTIFY_DEDUPE_ENABLED":   "false",
+				"ALGONOTIFY_ORIGIN_ALLOWLIST": "https://a.com,https://*.b.com",
+			},
+			check: func(t *testing.T, c *Server) {
+				if c.Port != "9000" || c.SendBufferSize != 7 || c.ShutdownTimeout != time.Minute || c.DedupeEnabled {
+					t.Fatalf("unexpected config %+v", c)
+				}
+
+				if want := []string{"https://a.com", "https://*.b.com"}; !reflect.DeepEqual(c.OriginAllowlist, want) {
+					t.Fatalf("origin_allowlist is %v, want %v", c.OriginAllowlist, want)
+				}
+			},
+		},
+		{
+			name:  "flags",
+			flags: []string{"--port=9001", "--dedupe-enabled=false", "--origin-allowlist=https://a.com,https://b.com"},
+			check: func(t *testing.T, c *Server) {
+				if c.Port != "9001" || c.DedupeEnabled {
+					t.Fatalf("unexpected config %+v", c)
+				}
+
+				if want := []string{"https://a.com", "https://b.com"}; !reflect.DeepEqual(c.OriginAllowlist, want) {
+					t.Fatalf("origin_allowlist is %v, want %v", c.OriginAllowlist, want)
+				}
+			},
+		},
+		{
+			name:  "flags take precedence over environment variables",
+			env:   map[string]string{"ALGONOTIFY_PORT": "9000", "ALGONOTIFY_LOG_LEVEL": "warn"},
+			flags: []string{"--port=9001"},
+			check: func(t *testing.T, c *Server) {
+				if c.Port != "9001" || c.LogLevel != "warn" {
+					t.Fatalf("unexpected config %+v", c)
+				}
+			},
+		},
+		{
+			name: "secret file from an environment variable",
+			env: map[string]string{
+				"ALGONOTIFY_REDIS_PASSWORD":      "from-env",
+				"ALGONOTIFY_REDIS_PASSWORD_FILE": secret,
+			},
+			check: func(t *testing.T, c *Server) {
+				// the file takes precedence over the secret and its trailing newline is removed
+				if c.RedisPassword != "from-file" {
+					t.Fatalf("redis_password is %q", c.RedisPassword)
+				}
+			},
+		},
+		{
+			name:  "secret file from a flag",
+			env:   map[string]string{"ALGONOTIFY_REDIS_PASSWORD_FILE": secret},
+			flags: []string{"--redis-password-file=" + other},
+			check: func(t *testing.T, c *Server) {
+				if c.RedisPassword != "from-flag-file" {
+					t.Fatalf("redis_password is %q", c.RedisPassword)
+				}
+			},
+		},
+		{
+			name:  "unset flags do not override",
+			flags: []string{},
+			check: func(t *testing.T, c *Server) {
+				if c.Port != "8080" || !c.DedupeEnabled {
+					t.Fatalf("unexpected config %+v", c)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			flags := pflag.NewFlagSet("server", pflag.ContinueOnError)
+			ServerFlags(flags)
+			if err := flags.Parse(tc.flags); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+
+			c, err := LoadServer("server.yaml", flags)
+			if err != nil {
+				t.Fatalf("failed to load: %v", err)
+			}
+
+			tc.check(t, c)
+		})
+	}
+}
+
+func TestLoadServerErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   map[string]string
+		field string
+	}{
+		{
+			name: "missing secret file",
+			env:  map[string]string{"ALGONOTIFY_REDIS_PASSWORD_FILE": filepath.Join(t.TempDir(), "missing")},
+		},
+		{
+			name:  "invalid override",
+			env:   map[string]string{"ALGONOTIFY_PORT": "70000"},
+			field: "port",
+		},
+		{
+			name:  "invalid list item",
+			env:   map[string]string{"ALGONOTIFY_EVENT_CHANNELS_PINNED": "NEW_BLOCK,UNKNOWN"},
+			field: "event_channels_pinned",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			_, err := LoadServer("server.yaml", nil)
+			if err == nil {
+				t.Fatal("an invalid config was loaded")
+			}
+
+			if tc.field == "" {
+				return
+			}
+
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			for _, f := range vErr.Fields {
+				if f.Field == tc.field {
+					return
+				}
+			}
+
+			t.Fatalf("%s was not reported in %v", tc.field, err)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// LatestRound starts monitoring from the latest round
+const LatestRound = "latest"
+
+// Monitor represents a configuration of the monitor command
+type Monitor struct {
+	IndexerHost        string        `mapstructure:"indexer_host"`
+	IndexerAPIToken    string        `mapstructure:"indexer_api_token" secret:"true"`
+	MetricsPort        string        `mapstructure:"metrics_port"`
+	StartRound         string        `mapstructure:"start_round"`
+	FetcherRPS         int           `mapstructure:"fetcher_rps" reload:"true"`
+	LogLevel           string        `mapstructure:"log_level" reload:"true"`
+	RedisHost          string        `mapstructure:"redis_host"`
+	RedisPassword      string        `mapstructure:"redis_password" secret:"true"`
+	PublisherTimeout   time.Duration `mapstructure:"publisher_timeout"`
+	NewBlockChannel    string        `mapstructure:"new_block_channel"`
+	PublishMode        []string      `mapstructure:"publish_mode"`
+	EventChannelPrefix string        `mapstructure:"event_channel_prefix"`
+	EventChannelsByID  bool          `mapstructure:"event_channels_by_id"`
+	CheckpointFile     string        `mapstructure:"checkpoint_file"`
+	ShutdownTimeout    time.Duration `mapstructure:"shutdown_timeout"`
+	ReadinessMaxLag    uint64        `mapstructure:"readiness_max_lag"`
+	ReadinessTimeout   time.Duration `mapstructure:"readiness_timeout"`
+
+	InterestKeyPrefix       string        `mapstructure:"interest_key_prefix"`
+	InterestRefreshInterval time.Duration `mapstructure:"interest_refresh_interval"`
+
+	LeaderElectionEnabled       bool          `mapstructure:"leader_election_enabled"`
+	LeaderElectionKey           string        `mapstructure:"leader_election_key"`
+	LeaderElectionTTL           time.Duration `mapstructure:"leader_election_ttl"`
+	LeaderElectionRenewInterval time.Duration `mapstructure:"leader_election_renew_interval"`
+	CheckpointKey               string        `mapstructure:"checkpoint_key"`
+}
+
+var monitorDefaults = map[string]interface{}{
+	"metrics_port":         "9361",
+	"start_round":          LatestRound,
+	"fetcher_rps":          5,
+	"log_level":            "info",
+	"publisher_timeout":    "3s",
+	"new_block_channel":    "algorand-notification-new-block",
+	"publish_mode":         []string{ModeBlock},
+	"event_channel_prefix": DefaultEventChannelPrefix,
+	"shutdown_timeout":     "30s",
+	"readiness_max_lag":    10,
+	"readiness_timeout":    "3s",
+
+	"interest_key_prefix":       DefaultInterestKeyPrefix,
+	"interest_refresh_interval": "1s",
+
+	"leader_election_key":            "algorand-notification-monitor-leader",
+	"leader_election_ttl":            "10s",
+	"leader_election_renew_interval": "3s",
+	"checkpoint_key":                 "algorand-notification-monitor-checkpoint",
+}
+
+// MonitorFlags registers a flag for every monitor config key
+func MonitorFlags(flags *pflag.FlagSet) {
+	addFlags(flags, reflect.TypeOf(Monitor{}))
+}
+
+// LoadMonitor reads and validates a monitor configuration file, flags may be nil
+func LoadMonitor(path string, flags *pflag.FlagSet) (*Monitor, error) {
+	c, _, err := loadMonitor(path, flags)
+
+	return c, err
+}
+
+// loadMonitor reads and validates a monitor configuration file, it also returns every file the configuration was read from
+func loadMonitor(path string, flags *pflag.FlagSet) (*Monitor, []string, error) {
+	var c Monitor
+	files, err := load(path, flags, monitorDefaults, &c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return &c, files, nil
+}
+
+// Round returns a start round, nil means the latest round
+func (c *Monitor) Round() *uint64 {
+	if c.StartRound == LatestRound {
+		return nil
+	}
+
+	round, err := strconv.ParseUint(c.StartRound, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &round
+}
+
+// Publishes returns true if blocks are published in the mode, both stands for block and events
+func (c *Monitor) Publishes(mode string) bool {
+	for _, m := range c.PublishMode {
+		if m == mode || (m == ModeBoth && (mode == ModeBlock || mode == ModeEvents)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate reports every invalid field at once
+func (c *Monitor) Validate() error {
+	v := &validator{}
+	v.check(c.RedisHost != "", "redis_host", "is required")
+	v.check(c.PublisherTimeout > 0, "publisher_timeout", "must be greater than 0")
+	v.check(c.NewBlockChannel != "", "new_block_channel", "is required")
+	v.check(len(c.PublishMode) > 0, "publish_mode", "is required")
+	for _, mode := range c.PublishMode {
+		v.check(mode == ModeBlock || mode == ModeEvents || mode == ModeBoth || mode == ModeRouted, "publish_mode", "mode "+mode+" must be one of block, events, both, routed")
+	}
+	v.check(!c.Publishes(ModeEvents) || c.EventChannelPrefix != "", "event_channel_prefix", "is required when publish_mode includes events")
+	if c.Publishes(ModeRouted) {
+		v.check(c.EventChannelPrefix != "", "event_channel_prefix", "is required when publish_mode includes routed")
+		v.check(c.InterestKeyPrefix != "", "interest_key_prefix", "is required when publish_mode includes routed")
+		v.check(c.InterestRefreshInterval > 0, "interest_refresh_interval", "must be greater than 0")
+	}
+	if c.LeaderElectionEnabled {
+		v.check(c.LeaderElectionKey != "", "leader_election_key", "is required when leader_election_enabled is true")
+		v.check(c.LeaderElectionTTL > 0, "leader_election_ttl", "must be greater than 0")
+		v.check(c.LeaderElectionRenewInterval > 0 && c.LeaderElectionRenewInterval < c.LeaderElectionTTL, "leader_election_renew_interval", "must be greater than 0 and less than leader_election_ttl")
+		v.check(c.CheckpointKey != "", "checkpoint_key", "is required when leader_election_enabled is true")
+	}
+	c.validate(v)
+
+	return v.err()
+}
+
+// validate checks every field except the Redis ones, which the standalone command does not need
+func (c *Monitor) validate(v *validator) {
+	v.check(c.IndexerHost != "", "indexer_host", "is required")
+	v.port(c.MetricsPort, "metrics_port")
+	_, err := strconv.ParseUint(c.StartRound, 10, 64)
+	v.check(c.StartRound == LatestRound || err == nil, "start_round", "must be latest or a round number")
+	v.check(c.FetcherRPS > 0, "fetcher_rps", "must be greater than 0")
+	v.logLevel(c.LogLevel, "log_level")
+	v.check(c.ShutdownTimeout > 0, "shutdown_timeout", "must be greater than 0")
+	v.check(c.ReadinessTimeout > 0, "readiness_timeout", "must be greater than 0")
+}
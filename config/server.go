@@ -0,0 +1,262 @@
+package config
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/tlsconfig"
+	"github.com/synycboom/algorand-notification/webhook"
+)
+
+// Server represents a configuration of the server command
+type Server struct {
+	Port            string `mapstructure:"port"`
+	GRPCPort        string `mapstructure:"grpc_port"`
+	MetricsPort     string `mapstructure:"metrics_port"`
+	LogLevel        string `mapstructure:"log_level" reload:"true"`
+	RedisHost       string `mapstructure:"redis_host"`
+	RedisPassword   string `mapstructure:"redis_password" secret:"true"`
+	NewBlockChannel string `mapstructure:"new_block_channel"`
+
+	SubscribeMode       string   `mapstructure:"subscribe_mode"`
+	EventChannelPrefix  string   `mapstructure:"event_channel_prefix"`
+	EventChannelsPinned []string `mapstructure:"event_channels_pinned"`
+
+	ReplicaID         string        `mapstructure:"replica_id"`
+	InterestKeyPrefix string        `mapstructure:"interest_key_prefix"`
+	InterestTTL       time.Duration `mapstructure:"interest_ttl"`
+
+	HubWorkerPoolSize       int           `mapstructure:"hub_worker_pool_size"`
+	PingInterval            time.Duration `mapstructure:"ping_interval"`
+	PongWaitTimeout         time.Duration `mapstructure:"pong_wait_timeout"`
+	WriteWaitTimeout        time.Duration `mapstructure:"write_wait_timeout"`
+	MaxReadMessageSize      int64         `mapstructure:"max_read_message_size"`
+	SendBufferSize          int           `mapstructure:"send_buffer_size"`
+	CompressionEnabled      bool          `mapstructure:"compression_enabled"`
+	CompressionLevel        int           `mapstructure:"compression_level"`
+	CompressionMinSize      int           `mapstructure:"compression_min_size"`
+	CompressionEvents       []string      `mapstructure:"compression_events"`
+	ProtocolVersion         int           `mapstructure:"protocol_version"`
+	EventStoreSize          int           `mapstructure:"event_store_size"`
+	StreamHeartbeatInterval time.Duration `mapstructure:"stream_heartbeat_interval"`
+	ShutdownTimeout         time.Duration `mapstructure:"shutdown_timeout"`
+	ShutdownReconnectAfter  time.Duration `mapstructure:"shutdown_reconnect_after"`
+	ReadinessTimeout        time.Duration `mapstructure:"readiness_timeout"`
+
+	DedupeEnabled           bool   `mapstructure:"dedupe_enabled"`
+	DedupeReorderTolerance  uint64 `mapstructure:"dedupe_reorder_tolerance"`
+	DedupeGapMessageEnabled bool   `mapstructure:"dedupe_gap_message_enabled"`
+
+	OriginAllowlist []string `mapstructure:"origin_allowlist" reload:"true"`
+
+	TLSEnabled      bool   `mapstructure:"tls_enabled"`
+	TLSCertFile     string `mapstructure:"tls_cert_file"`
+	TLSKeyFile      string `mapstructure:"tls_key_file"`
+	TLSClientCAFile string `mapstructure:"tls_client_ca_file"`
+	TLSClientAuth   string `mapstructure:"tls_client_auth"`
+
+	AuthEnabled          bool          `mapstructure:"auth_enabled"`
+	AuthTimeout          time.Duration `mapstructure:"auth_timeout"`
+	AuthAPIKeysFile      string        `mapstructure:"auth_api_keys_file" reload:"true"`
+	AuthJWTAlgorithm     string        `mapstructure:"auth_jwt_algorithm" reload:"true"`
+	AuthJWTSecret        string        `mapstructure:"auth_jwt_secret" reload:"true" secret:"true"`
+	AuthJWTPublicKeyFile string        `mapstructure:"auth_jwt_public_key_file" reload:"true"`
+
+	WebhookEnabled             bool                   `mapstructure:"webhook_enabled"`
+	WebhookAPIEnabled          bool                   `mapstructure:"webhook_api_enabled"`
+	WebhookAPIToken            string                 `mapstructure:"webhook_api_token" secret:"true"`
+	WebhookAllowHTTP           bool                   `mapstructure:"webhook_allow_http"`
+	WebhookAllowPrivateTargets bool                   `mapstructure:"webhook_allow_private_targets"`
+	WebhookMaxAttempts         int                    `mapstructure:"webhook_max_attempts"`
+	WebhookInitialBackoff      time.Duration          `mapstructure:"webhook_initial_backoff"`
+	WebhookMaxBackoff          time.Duration          `mapstructure:"webhook_max_backoff"`
+	WebhookTimeout             time.Duration          `mapstructure:"webhook_timeout"`
+	WebhookQueueSize           int                    `mapstructure:"webhook_queue_size"`
+	WebhookDeadLetterSize      int                    `mapstructure:"webhook_dead_letter_size"`
+	WebhookSubscriptions       []webhook.Subscription `mapstructure:"webhook_subscriptions"`
+
+	QuotaMaxConnectionsPerTenant       int     `mapstructure:"quota_max_connections_per_tenant" reload:"true"`
+	QuotaMaxSubscriptionsPerConnection int     `mapstructure:"quota_max_subscriptions_per_connection" reload:"true"`
+	QuotaMaxParamsPerRequest           int     `mapstructure:"quota_max_params_per_request" reload:"true"`
+	QuotaRequestRate                   float64 `mapstructure:"quota_request_rate" reload:"true"`
+	QuotaRequestBurst                  int     `mapstructure:"quota_request_burst" reload:"true"`
+}
+
+var serverDefaults = map[string]interface{}{
+	"port":                      "8080",
+	"grpc_port":                 "8081",
+	"metrics_port":              "9360",
+	"log_level":                 "info",
+	"new_block_channel":         "algorand-notification-new-block",
+	"subscribe_mode":            ModeBlock,
+	"event_channel_prefix":      DefaultEventChannelPrefix,
+	"interest_key_prefix":       DefaultInterestKeyPrefix,
+	"interest_ttl":              "30s",
+	"hub_worker_pool_size":      30000,
+	"ping_interval":             "3m",
+	"pong_wait_timeout":         "3m15s",
+	"write_wait_timeout":        "5s",
+	"max_read_message_size":     1024,
+	"send_buffer_size":          100,
+	"compression_level":         1,
+	"compression_min_size":      1024,
+	"protocol_version":          event.Version1,
+	"event_store_size":          10000,
+	"stream_heartbeat_interval": "15s",
+	"shutdown_timeout":          "30s",
+	"shutdown_reconnect_after":  "5s",
+	"readiness_timeout":         "3s",
+	"dedupe_enabled":            true,
+	"origin_allowlist":          []string{"*"},
+	"tls_client_auth":           tlsconfig.ClientAuthNone,
+	"auth_timeout":              "10s",
+	"webhook_max_attempts":      5,
+	"webhook_initial_backoff":   "1s",
+	"webhook_max_backoff":       "1m",
+	"webhook_timeout":           "10s",
+	"webhook_queue_size":        1000,
+	"webhook_dead_letter_size":  1000,
+}
+
+// ServerFlags registers a flag for every server config key
+func ServerFlags(flags *pflag.FlagSet) {
+	addFlags(flags, reflect.TypeOf(Server{}))
+}
+
+// LoadServer reads and validates a server configuration file, flags may be nil
+func LoadServer(path string, flags *pflag.FlagSet) (*Server, error) {
+	c, _, err := loadServer(path, flags)
+
+	return c, err
+}
+
+// loadServer reads and validates a server configuration file, it also returns every file the configuration was read from
+func loadServer(path string, flags *pflag.FlagSet) (*Server, []string, error) {
+	var c Server
+	files, err := load(path, flags, serverDefaults, &c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return &c, append(files, c.keyFiles()...), nil
+}
+
+// keyFiles returns the API keys file and the JWT public key file if they are set, both are read again on reload
+func (c *Server) keyFiles() []string {
+	var files []string
+	if c.AuthAPIKeysFile != "" {
+		files = append(files, c.AuthAPIKeysFile)
+	}
+
+	if c.AuthJWTPublicKeyFile != "" {
+		files = append(files, c.AuthJWTPublicKeyFile)
+	}
+
+	return files
+}
+
+// Validate reports every invalid field at once
+func (c *Server) Validate() error {
+	v := &validator{}
+	v.check(c.RedisHost != "", "redis_host", "is required")
+	v.check(c.NewBlockChannel != "", "new_block_channel", "is required")
+	v.check(c.SubscribeMode == ModeBlock || c.SubscribeMode == ModeEvents || c.SubscribeMode == ModeRouted, "subscribe_mode", "must be one of block, events, routed")
+	v.check(c.SubscribeMode == ModeBlock || c.EventChannelPrefix != "", "event_channel_prefix", "is required when subscribe_mode is events or routed")
+	if c.SubscribeMode == ModeRouted {
+		v.check(c.InterestKeyPrefix != "", "interest_key_prefix", "is required when subscribe_mode is routed")
+		v.check(c.InterestTTL > 0, "interest_ttl", "must be greater than 0")
+	}
+	for _, t := range c.EventChannelsPinned {
+		v.check(isEvent(t), "event_channels_pinned", "event "+t+" is invalid")
+	}
+	c.validate(v)
+
+	return v.err()
+}
+
+// validate checks every field except the Redis ones, which the standalone command does not need
+func (c *Server) validate(v *validator) {
+	v.port(c.Port, "port")
+	v.port(c.GRPCPort, "grpc_port")
+	v.port(c.MetricsPort, "metrics_port")
+	v.logLevel(c.LogLevel, "log_level")
+
+	v.check(c.HubWorkerPoolSize > 0, "hub_worker_pool_size", "must be greater than 0")
+	v.check(c.PingInterval > 0, "ping_interval", "must be greater than 0")
+	v.check(c.PongWaitTimeout > c.PingInterval, "pong_wait_timeout", "must be greater than ping_interval")
+	v.check(c.WriteWaitTimeout > 0, "write_wait_timeout", "must be greater than 0")
+	v.check(c.MaxReadMessageSize > 0, "max_read_message_size", "must be greater than 0")
+	v.check(c.SendBufferSize > 0, "send_buffer_size", "must be greater than 0")
+	if c.CompressionEnabled {
+		v.check(c.CompressionLevel >= -2 && c.CompressionLevel <= 9, "compression_level", "must be between -2 and 9")
+		v.check(c.CompressionMinSize >= 0, "compression_min_size", "must not be negative")
+		for _, t := range c.CompressionEvents {
+			v.check(isEvent(t), "compression_events", "event "+t+" is invalid")
+		}
+	}
+	v.check(event.IsVersion(c.ProtocolVersion), "protocol_version", "must be 1 or 2")
+	v.check(c.EventStoreSize > 0, "event_store_size", "must be greater than 0")
+	v.check(c.StreamHeartbeatInterval > 0, "stream_heartbeat_interval", "must be greater than 0")
+	v.check(c.ShutdownTimeout > 0, "shutdown_timeout", "must be greater than 0")
+	v.check(c.ShutdownReconnectAfter >= 0, "shutdown_reconnect_after", "must not be negative")
+	v.check(c.ReadinessTimeout > 0, "readiness_timeout", "must be greater than 0")
+
+	if c.TLSEnabled {
+		v.check(c.TLSCertFile != "", "tls_cert_file", "is required when tls_enabled is true")
+		v.check(c.TLSKeyFile != "", "tls_key_file", "is required when tls_enabled is true")
+		v.check(
+			c.TLSClientAuth == tlsconfig.ClientAuthNone || c.TLSClientAuth == tlsconfig.ClientAuthRequest || c.TLSClientAuth == tlsconfig.ClientAuthRequire,
+			"tls_client_auth", "must be one of none, request, require",
+		)
+		v.check(c.TLSClientAuth == tlsconfig.ClientAuthNone || c.TLSClientCAFile != "", "tls_client_ca_file", "is required when tls_client_auth is not none")
+	}
+
+	if c.AuthEnabled {
+		v.check(c.AuthTimeout > 0, "auth_timeout", "must be greater than 0")
+		v.check(c.AuthAPIKeysFile != "" || c.AuthJWTAlgorithm != "", "auth_api_keys_file", "either auth_api_keys_file or auth_jwt_algorithm is required when auth_enabled is true")
+		v.check(c.AuthJWTAlgorithm == "" || c.AuthJWTAlgorithm == auth.HS256 || c.AuthJWTAlgorithm == auth.RS256, "auth_jwt_algorithm", "must be HS256 or RS256")
+		v.check(c.AuthJWTAlgorithm != auth.HS256 || c.AuthJWTSecret != "", "auth_jwt_secret", "is required for HS256")
+		v.check(c.AuthJWTAlgorithm != auth.RS256 || c.AuthJWTPublicKeyFile != "", "auth_jwt_public_key_file", "is required for RS256")
+	}
+
+	if c.WebhookEnabled {
+		v.check(c.WebhookMaxAttempts > 0, "webhook_max_attempts", "must be greater than 0")
+		v.check(c.WebhookInitialBackoff > 0, "webhook_initial_backoff", "must be greater than 0")
+		v.check(c.WebhookMaxBackoff >= c.WebhookInitialBackoff, "webhook_max_backoff", "must not be less than webhook_initial_backoff")
+		v.check(c.WebhookTimeout > 0, "webhook_timeout", "must be greater than 0")
+		v.check(c.WebhookQueueSize > 0, "webhook_queue_size", "must be greater than 0")
+		v.check(c.WebhookDeadLetterSize >= 0, "webhook_dead_letter_size", "must not be negative")
+		v.check(!c.WebhookAPIEnabled || c.AuthEnabled || c.WebhookAPIToken != "", "webhook_api_enabled", "requires auth_enabled or webhook_api_token")
+		for _, sub := range c.WebhookSubscriptions {
+			v.check(sub.ID != "" && sub.URL != "" && len(sub.Events) > 0, "webhook_subscriptions", "id, url and events are required for every subscription")
+			for _, t := range sub.Events {
+				v.check(isEvent(t), "webhook_subscriptions", "event "+t+" of "+sub.ID+" is invalid")
+			}
+		}
+	}
+
+	v.check(c.QuotaMaxConnectionsPerTenant >= 0, "quota_max_connections_per_tenant", "must not be negative")
+	v.check(c.QuotaMaxSubscriptionsPerConnection >= 0, "quota_max_subscriptions_per_connection", "must not be negative")
+	v.check(c.QuotaMaxParamsPerRequest >= 0, "quota_max_params_per_request", "must not be negative")
+	v.check(c.QuotaRequestRate >= 0, "quota_request_rate", "must not be negative")
+	v.check(c.QuotaRequestBurst >= 0, "quota_request_burst", "must not be negative")
+	v.check(c.QuotaRequestRate == 0 || c.QuotaRequestBurst > 0, "quota_request_burst", "must be greater than 0 when quota_request_rate is set")
+}
+
+func isEvent(t string) bool {
+	for _, e := range event.AllEvents {
+		if e == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"reflect"
+
+	"github.com/spf13/pflag"
+)
+
+// Standalone represents a configuration of the standalone command.
+// It is a single file with both server and monitor keys, Redis keys are ignored.
+type Standalone struct {
+	Server  Server
+	Monitor Monitor
+}
+
+// StandaloneFlags registers a flag for every server and monitor config key
+func StandaloneFlags(flags *pflag.FlagSet) {
+	addFlags(flags, reflect.TypeOf(Server{}))
+	addFlags(flags, reflect.TypeOf(Monitor{}))
+}
+
+// LoadStandalone reads and validates a standalone configuration file, flags may be nil
+func LoadStandalone(path string, flags *pflag.FlagSet) (*Standalone, error) {
+	c, _, err := loadStandalone(path, flags)
+
+	return c, err
+}
+
+// loadStandalone reads and validates a standalone configuration file, it also returns every file the configuration was read from
+func loadStandalone(path string, flags *pflag.FlagSet) (*Standalone, []string, error) {
+	var c Standalone
+	serverFiles, err := load(path, flags, serverDefaults, &c.Server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitorFiles, err := load(path, flags, monitorDefaults, &c.Monitor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	files := append(serverFiles, monitorFiles...)
+
+	return &c, append(files, c.Server.keyFiles()...), nil
+}
+
+// Validate reports every invalid field at once
+func (c *Standalone) Validate() error {
+	v := &validator{}
+	c.Server.validate(v)
+	c.Monitor.validate(v)
+	// blocks are passed in memory, so per-type channels are not used
+	v.check(c.Monitor.Publishes(ModeBlock) && !c.Monitor.Publishes(ModeEvents) && !c.Monitor.Publishes(ModeRouted), "publish_mode", "must be block in standalone mode")
+	v.check(c.Server.SubscribeMode == ModeBlock, "subscribe_mode", "must be block in standalone mode")
+
+	return v.err()
+}
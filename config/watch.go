@@ -0,0 +1,326 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/pflag"
+)
+
+// reloadDelay collapses the bursts of events editors produce when saving a file
+const reloadDelay = 100 * time.Millisecond
+
+// Change represents a changed field of a reloaded configuration
+type Change struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+
+	// Reloadable is false if the change only takes effect after a restart
+	Reloadable bool
+}
+
+// String returns the change, secrets are redacted
+func (c Change) String() string {
+	s := fmt.Sprintf("%s: %v -> %v", c.Field, c.Old, c.New)
+	if !c.Reloadable {
+		s += " (requires restart)"
+	}
+
+	return s
+}
+
+// Watcher reloads a configuration file when it or a file it refers to changes
+type Watcher struct {
+	watcher  *fsnotify.Watcher
+	files    map[string]struct{}
+	dirs     map[string]struct{}
+	reload   func() []string
+	mu       sync.Mutex
+	timer    *time.Timer
+	reloadMu sync.Mutex
+}
+
+// WatchServer watches a server configuration file, its secret files, the API keys file and the JWT public key file.
+// Valid changes of reloadable fields are merged into a copy of the current configuration and passed to apply,
+// an invalid file or an apply error keeps the current configuration.
+// Files are watched again after each applied reload, so that changed paths are followed.
+func WatchServer(path string, flags *pflag.FlagSet, current Server, apply func(*Server) error) (*Watcher, error) {
+	_, files, err := loadServer(path, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return watch(files, func() []string {
+		next, files, err := loadServer(path, flags)
+		if err != nil {
+			log.Error().Err(err).Msg("config: rejected an invalid reload, keep using the current config")
+
+			return nil
+		}
+
+		merged := current
+		changes := merge(&merged, next)
+		if err := apply(&merged); err != nil {
+			log.Error().Err(err).Msg("config: failed to apply a reload, keep using the current config")
+
+			return nil
+		}
+
+		current = merged
+		logChanges(changes)
+
+		return files
+	})
+}
+
+// WatchMonitor watches a monitor configuration file and its secret files.
+// Valid changes of reloadable fields are merged into a copy of the current configuration and passed to apply,
+// an invalid file or an apply error keeps the current configuration.
+// Files are watched again after each applied reload, so that changed paths are followed.
+func WatchMonitor(path string, flags *pflag.FlagSet, current Monitor, apply func(*Monitor) error) (*Watcher, error) {
+	_, files, err := loadMonitor(path, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return watch(files, func() []string {
+		next, files, err := loadMonitor(path, flags)
+		if err != nil {
+			log.Error().Err(err).Msg("config: rejected an invalid reload, keep using the current config")
+
+			return nil
+		}
+
+		merged := current
+		changes := merge(&merged, next)
+		if err := apply(&merged); err != nil {
+			log.Error().Err(err).Msg("config: failed to apply a reload, keep using the current config")
+
+			return nil
+		}
+
+		current = merged
+		logChanges(changes)
+
+		return files
+	})
+}
+
+// WatchStandalone watches a standalone configuration file, its secret files, the API keys file and the JWT public key file.
+// Valid changes of reloadable fields are merged into a copy of the current configuration and passed to apply,
+// an invalid file or an apply error keeps the current configuration.
+// Files are watched again after each applied reload, so that changed paths are followed.
+func WatchStandalone(path string, flags *pflag.FlagSet, current Standalone, apply func(*Standalone) error) (*Watcher, error) {
+	_, files, err := loadStandalone(path, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return watch(files, func() []string {
+		next, files, err := loadStandalone(path, flags)
+		if err != nil {
+			log.Error().Err(err).Msg("config: rejected an invalid reload, keep using the current config")
+
+			return nil
+		}
+
+		merged := current
+		changes := merge(&merged.Server, &next.Server)
+		changes = append(changes, merge(&merged.Monitor, &next.Monitor)...)
+		if err := apply(&merged); err != nil {
+			log.Error().Err(err).Msg("config: failed to apply a reload, keep using the current config")
+
+			return nil
+		}
+
+		current = merged
+		logChanges(changes)
+
+		return files
+	})
+}
+
+// Close stops watching
+func (w *Watcher) Close() error {
+	return w.watcher.Close()
+}
+
+// watch calls reload when one of the files changes, reload returns the files to watch from then on, nil keeps them
+func watch(files []string, reload func() []string) (*Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		watcher: watcher,
+		files:   make(map[string]struct{}),
+		dirs:    make(map[string]struct{}),
+		reload:  reload,
+	}
+
+	if err := w.sync(files); err != nil {
+		_ = watcher.Close()
+
+		return nil, err
+	}
+
+	go w.run()
+
+	return w, nil
+}
+
+// sync watches the files instead of the previous ones
+func (w *Watcher) sync(files []string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// watch directories rather than files, so that atomic replacements (e.g. Kubernetes config maps) are noticed
+	w.files = make(map[string]struct{})
+	dirs := make(map[string]struct{})
+	for _, f := range files {
+		w.files[filepath.Clean(f)] = struct{}{}
+		dirs[filepath.Dir(f)] = struct{}{}
+	}
+
+	for dir := range dirs {
+		if _, exist := w.dirs[dir]; exist {
+			continue
+		}
+
+		if err := w.watcher.Add(dir); err != nil {
+			return err
+		}
+		w.dirs[dir] = struct{}{}
+	}
+
+	for dir := range w.dirs {
+		if _, exist := dirs[dir]; !exist {
+			_ = w.watcher.Remove(dir)
+			delete(w.dirs, dir)
+		}
+	}
+
+	return nil
+}
+
+// isWatched returns true if a file is watched
+func (w *Watcher) isWatched(name string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, watched := w.files[filepath.Clean(name)]
+
+	return watched
+}
+
+func (w *Watcher) run() {
+	for {
+		select {
+		case e, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if e.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+
+			// Kubernetes swaps a ..data symlink, so any event in the directory of a mounted file counts
+			if !w.isWatched(e.Name) && filepath.Base(e.Name) != "..data" {
+				continue
+			}
+
+			w.schedule()
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			log.Error().Err(err).Msg("config: watcher error")
+		}
+	}
+}
+
+func (w *Watcher) schedule() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+
+	w.timer = time.AfterFunc(reloadDelay, func() {
+		w.reloadMu.Lock()
+		defer w.reloadMu.Unlock()
+
+		if files := w.reload(); files != nil {
+			if err := w.sync(files); err != nil {
+				log.Error().Err(err).Msg("config: failed to watch reloaded files")
+			}
+		}
+	})
+}
+
+// merge copies fields tagged with reload:"true" from next into current and returns every changed field
+func merge(current, next interface{}) []Change {
+	cv := reflect.ValueOf(current).Elem()
+	nv := reflect.ValueOf(next).Elem()
+	t := cv.Type()
+
+	var changes []Change
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if reflect.DeepEqual(cv.Field(i).Interface(), nv.Field(i).Interface()) {
+			continue
+		}
+
+		change := Change{
+			Field:      field.Tag.Get("mapstructure"),
+			Old:        cv.Field(i).Interface(),
+			New:        nv.Field(i).Interface(),
+			Reloadable: field.Tag.Get("reload") == "true",
+		}
+		if field.Tag.Get("secret") == "true" {
+			change.Old, change.New = "***", "***"
+		}
+		changes = append(changes, change)
+
+		if change.Reloadable {
+			cv.Field(i).Set(nv.Field(i))
+		}
+	}
+
+	return changes
+}
+
+func logChanges(changes []Change) {
+	if len(changes) == 0 {
+		log.Info().Msg("config: reloaded without changes")
+
+		return
+	}
+
+	logged := make(map[string]struct{})
+	for _, c := range changes {
+		// keys shared by the server and the monitor are reported once for the standalone command
+		if _, exist := logged[c.Field]; exist {
+			continue
+		}
+		logged[c.Field] = struct{}{}
+
+		if !c.Reloadable {
+			log.Warn().Msgf("config: changed %s", c)
+
+			continue
+		}
+
+		log.Info().Msgf("config: changed %s", c)
+	}
+}
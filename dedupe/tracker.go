@@ -0,0 +1,97 @@
+package dedupe
+
+import (
+	"sync"
+)
+
+const (
+	// Duplicate is a drop reason for a round that was already accepted
+	Duplicate = "duplicate"
+
+	// OutOfOrder is a drop reason for a round older than the tolerance allows
+	OutOfOrder = "out_of_order"
+)
+
+// Result is an outcome of observing a round
+type Result struct {
+	// Accepted is false if the round must be discarded, Reason tells why
+	Accepted bool
+	Reason   string
+
+	// GapFrom and GapTo are the first and the last skipped rounds, they are 0 if no round was skipped
+	GapFrom uint64
+	GapTo   uint64
+}
+
+// HasGap returns true if rounds were skipped before the observed round
+func (r Result) HasGap() bool {
+	return r.GapTo != 0
+}
+
+// Tracker tracks the highest round seen on each channel
+type Tracker struct {
+	mu        sync.Mutex
+	tolerance uint64
+	channels  map[string]*window
+}
+
+// window keeps the highest round and the rounds accepted within the tolerance below it
+type window struct {
+	highest uint64
+	seen    map[uint64]struct{}
+}
+
+// New creates a new tracker, rounds up to tolerance below the highest one are still accepted once
+func New(tolerance uint64) *Tracker {
+	return &Tracker{
+		tolerance: tolerance,
+		channels:  make(map[string]*window),
+	}
+}
+
+// Observe records a round of a channel and tells whether it should be delivered
+func (t *Tracker) Observe(channel string, round uint64) Result {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	w, exist := t.channels[channel]
+	if !exist {
+		t.channels[channel] = &window{
+			highest: round,
+			seen:    map[uint64]struct{}{round: {}},
+		}
+
+		return Result{Accepted: true}
+	}
+
+	if round > w.highest {
+		var res Result
+		if round > w.highest+1 {
+			res.GapFrom = w.highest + 1
+			res.GapTo = round - 1
+		}
+
+		res.Accepted = true
+		w.highest = round
+		w.seen[round] = struct{}{}
+		for r := range w.seen {
+			if w.highest-r > t.tolerance {
+				delete(w.seen, r)
+			}
+		}
+
+		return res
+	}
+
+	if _, exist := w.seen[round]; exist {
+		return Result{Reason: Duplicate}
+	}
+
+	if w.highest-round > t.tolerance {
+		return Result{Reason: OutOfOrder}
+	}
+
+	w.seen[round] = struct{}{}
+
+	return Result{Accepted: true}
+}
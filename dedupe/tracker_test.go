@@ -0,0 +1,121 @@
+package dedupe
+
+import (
+	"testing"
+)
+
+func TestTrackerObserve(t *testing.T) {
+	type observation struct {
+		channel string
+		round   uint64
+		want    Result
+	}
+
+	tests := []struct {
+		name         string
+		tolerance    uint64
+		observations []observation
+	}{
+		{
+			name:      "consecutive rounds",
+			tolerance: 2,
+			observations: []observation{
+				{channel: "a", round: 10, want: Result{Accepted: true}},
+				{channel: "a", round: 11, want: Result{Accepted: true}},
+				{channel: "a", round: 12, want: Result{Accepted: true}},
+			},
+		},
+		{
+			name:      "duplicates",
+			tolerance: 2,
+			observations: []observation{
+				{channel: "a", round: 10, want: Result{Accepted: true}},
+				{channel: "a", round: 10, want: Result{Reason: Duplicate}},
+				{channel: "a", round: 11, want: Result{Accepted: true}},
+				{channel: "a", round: 10, want: Result{Reason: Duplicate}},
+				{channel: "a", round: 11, want: Result{Reason: Duplicate}},
+			},
+		},
+		{
+			name:      "out of order within the tolerance",
+			tolerance: 2,
+			observations: []observation{
+				{channel: "a", round: 10, want: Result{Accepted: true}},
+				{channel: "a", round: 12, want: Result{Accepted: true, GapFrom: 11, GapTo: 11}},
+				{channel: "a", round: 11, want: Result{Accepted: true}},
+				{channel: "a", round: 11, want: Result{Reason: Duplicate}},
+			},
+		},
+		{
+			name:      "out of order beyond the tolerance",
+			tolerance: 2,
+			observations: []observation{
+				{channel: "a", round: 10, want: Result{Accepted: true}},
+				{channel: "a", round: 14, want: Result{Accepted: true, GapFrom: 11, GapTo: 13}},
+				{channel: "a", round: 11, want: Result{Reason: OutOfOrder}},
+				{channel: "a", round: 12, want: Result{Accepted: true}},
+				{channel: "a", round: 10, want: Result{Reason: OutOfOrder}},
+			},
+		},
+		{
+			name:      "zero tolerance",
+			tolerance: 0,
+			observations: []observation{
+				{channel: "a", round: 10, want: Result{Accepted: true}},
+				{channel: "a", round: 12, want: Result{Accepted: true, GapFrom: 11, GapTo: 11}},
+				{channel: "a", round: 11, want: Result{Reason: OutOfOrder}},
+				{channel: "a", round: 12, want: Result{Reason: Duplicate}},
+			},
+		},
+		{
+			name:      "gap ranges",
+			tolerance: 5,
+			observations: []observation{
+				{channel: "a", round: 100, want: Result{Accepted: true}},
+				{channel: "a", round: 101, want: Result{Accepted: true}},
+				{channel: "a", round: 104, want: Result{Accepted: true, GapFrom: 102, GapTo: 103}},
+				{channel: "a", round: 1000, want: Result{Accepted: true, GapFrom: 105, GapTo: 999}},
+				{channel: "a", round: 1001, want: Result{Accepted: true}},
+			},
+		},
+		{
+			name:      "channels are tracked separately",
+			tolerance: 2,
+			observations: []observation{
+				{channel: "a", round: 10, want: Result{Accepted: true}},
+				{channel: "b", round: 20, want: Result{Accepted: true}},
+				{channel: "b", round: 10, want: Result{Reason: OutOfOrder}},
+				{channel: "a", round: 10, want: Result{Reason: Duplicate}},
+				{channel: "a", round: 13, want: Result{Accepted: true, GapFrom: 11, GapTo: 12}},
+				{channel: "b", round: 21, want: Result{Accepted: true}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := New(tc.tolerance)
+			for i, o := range tc.observations {
+				got := tracker.Observe(o.channel, o.round)
+				if got != o.want {
+					t.Fatalf("observation %d of round %v on %s: got %+v, want %+v", i, o.round, o.channel, got, o.want)
+				}
+
+				if got.HasGap() != (o.want.GapTo != 0) {
+					t.Fatalf("observation %d: HasGap returned %v", i, got.HasGap())
+				}
+			}
+		})
+	}
+}
+
+func TestTrackerForgetsRoundsBeyondTheTolerance(t *testing.T) {
+	tracker := New(3)
+	for round := uint64(1); round <= 100; round++ {
+		tracker.Observe("a", round)
+	}
+
+	if n := len(tracker.channels["a"].seen); n > 4 {
+		t.Fatalf("tracker keeps %d rounds, want at most 4", n)
+	}
+}
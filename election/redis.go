@@ -0,0 +1,223 @@
+package election
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/rs/zerolog/log"
+	"go.uber.org/atomic"
+
+	"github.com/synycboom/algorand-notification/instance"
+	"github.com/synycboom/algorand-notification/metrics"
+)
+
+var (
+	// acquireScript takes the lease if it is free and returns a new fencing token, or 0 if another instance holds it
+	acquireScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return redis.call("INCR", KEYS[2])
+end
+return 0
+`)
+
+	// renewScript extends the lease if it is still held by the instance
+	renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+	// releaseScript deletes the lease if it is still held by the instance
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+)
+
+// LeadFunc runs while the instance is the leader, ctx is done when the leadership is lost or the election stops.
+// The token increases with every term and should be used to fence writes of a stale leader.
+type LeadFunc func(ctx context.Context, token uint64)
+
+// RedisConfig represents a configuration for Redis leader election
+type RedisConfig struct {
+	RedisHost     string
+	RedisPassword string
+	// Key is the lease key, the latest fencing token is kept in FenceKey(Key)
+	Key           string
+	TTL           time.Duration
+	RenewInterval time.Duration
+}
+
+// Redis elects a single leader among instances sharing a lease key
+type Redis struct {
+	conf   RedisConfig
+	id     string
+	rdb    *redis.Client
+	leader atomic.Bool
+}
+
+// FenceKey returns the key that holds the fencing token of the current term
+func FenceKey(key string) string {
+	return key + ":token"
+}
+
+// NewRedis creates a new Redis leader election
+func NewRedis(conf RedisConfig) (*Redis, error) {
+	id, err := instance.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     conf.RedisHost,
+		Password: conf.RedisPassword,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(10)*time.Second)
+	defer cancel()
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+
+	log.Info().Msgf("election: connected to Redis as %s", id)
+
+	return &Redis{
+		conf: conf,
+		id:   id,
+		rdb:  rdb,
+	}, nil
+}
+
+// ID returns the identity of the instance
+func (e *Redis) ID() string {
+	return e.id
+}
+
+// IsLeader returns true while the instance holds the lease
+func (e *Redis) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Close closes the Redis connection
+func (e *Redis) Close() error {
+	return e.rdb.Close()
+}
+
+// Run campaigns for the leadership until the context is done and calls lead for every won term.
+// The lease is kept until lead returns, so a leader can finish its work after the context is done.
+func (e *Redis) Run(ctx context.Context, lead LeadFunc) {
+	ticker := time.NewTicker(e.conf.RenewInterval)
+	defer ticker.Stop()
+
+	for {
+		token, err := e.acquire(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("election: failed to acquire the lease")
+		}
+
+		if token > 0 {
+			e.lead(ctx, token, ticker, lead)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Redis) lead(ctx context.Context, token uint64, ticker *time.Ticker, lead LeadFunc) {
+	log.Info().Msgf("election: became the leader with the fencing token %v", token)
+
+	e.leader.Store(true)
+	metrics.ObserveLeadership(true, token)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	doneChan := make(chan struct{})
+	go func() {
+		defer close(doneChan)
+
+		lead(leaderCtx, token)
+	}()
+
+	expiry := time.Now().Add(e.conf.TTL)
+	held := true
+	for {
+		select {
+		case <-doneChan:
+			if held {
+				e.release()
+			}
+
+			e.leader.Store(false)
+			metrics.ObserveLeadership(false, token)
+
+			log.Info().Msg("election: stepped down")
+
+			return
+		case <-ticker.C:
+			if !held {
+				continue
+			}
+
+			renewed, err := e.renew()
+			if err == nil && renewed {
+				expiry = time.Now().Add(e.conf.TTL)
+
+				continue
+			}
+
+			if err == nil {
+				log.Warn().Msg("election: lost the lease to another instance")
+			} else if time.Now().Before(expiry) {
+				log.Error().Err(err).Msg("election: failed to renew the lease, retrying")
+
+				continue
+			} else {
+				log.Error().Err(err).Msg("election: failed to renew the lease before it expired")
+			}
+
+			held = false
+			cancel()
+		}
+	}
+}
+
+func (e *Redis) acquire(ctx context.Context) (uint64, error) {
+	token, err := acquireScript.Run(ctx, e.rdb, []string{e.conf.Key, FenceKey(e.conf.Key)}, e.id, e.conf.TTL.Milliseconds()).Uint64()
+	if err != nil {
+		return 0, err
+	}
+
+	return token, nil
+}
+
+func (e *Redis) renew() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.conf.RenewInterval)
+	defer cancel()
+
+	renewed, err := renewScript.Run(ctx, e.rdb, []string{e.conf.Key}, e.id, e.conf.TTL.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return renewed == 1, nil
+}
+
+// release deletes the lease so that a standby does not wait for it to expire
+func (e *Redis) release() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.conf.RenewInterval)
+	defer cancel()
+
+	if err := releaseScript.Run(ctx, e.rdb, []string{e.conf.Key}, e.id).Err(); err != nil {
+		log.Error().Err(err).Msg("election: failed to release the lease")
+	}
+}
@@ -0,0 +1,127 @@
+package event
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// batch is a wire format of events of a round published to an event channel
+type batch struct {
+	Network   string      `json:"network,omitempty"`
+	Round     uint64      `json:"round"`
+	Timestamp uint64      `json:"timestamp,omitempty"`
+	Events    []wireEvent `json:"events"`
+}
+
+type wireEvent struct {
+	Type      string          `json:"type"`
+	Offset    uint64          `json:"offset,omitempty"`
+	TxID      string          `json:"txId,omitempty"`
+	Addresses []string        `json:"addresses,omitempty"`
+	AssetID   uint64          `json:"assetId,omitempty"`
+	AppID     uint64          `json:"appId,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// Channel returns an event channel of an event type
+func Channel(prefix, eventType string) string {
+	return prefix + ":" + eventType
+}
+
+// AssetChannel returns an event channel of an event type and an asset
+func AssetChannel(prefix, eventType string, assetID uint64) string {
+	return Channel(prefix, eventType) + ":asset:" + strconv.FormatUint(assetID, 10)
+}
+
+// AppChannel returns an event channel of an event type and an application
+func AppChannel(prefix, eventType string, appID uint64) string {
+	return Channel(prefix, eventType) + ":app:" + strconv.FormatUint(appID, 10)
+}
+
+// ReplicaChannel returns an event channel of a server replica, it receives events routed by the replica interest
+func ReplicaChannel(prefix, replica string) string {
+	return prefix + ":replica:" + replica
+}
+
+// MarshalBatch serializes parsed events of a round, so that consumers do not parse the block again
+func MarshalBatch(round uint64, events []*Event) ([]byte, error) {
+	b := batch{
+		Round:  round,
+		Events: make([]wireEvent, 0, len(events)),
+	}
+	if len(events) > 0 {
+		b.Network = events[0].Network
+		b.Timestamp = events[0].Timestamp
+	}
+
+	for _, e := range events {
+		b.Events = append(b.Events, wireEvent{
+			Type:      e.Type,
+			Offset:    e.Offset,
+			TxID:      e.TxID,
+			Addresses: e.Addresses,
+			AssetID:   e.AssetID,
+			AppID:     e.AppID,
+			Payload:   e.Payload,
+		})
+	}
+
+	return json.Marshal(b)
+}
+
+// UnmarshalBatch deserializes events of a round serialized by MarshalBatch
+func UnmarshalBatch(data []byte) (uint64, []*Event, error) {
+	var b batch
+	if err := json.Unmarshal(data, &b); err != nil {
+		return 0, nil, err
+	}
+
+	events := make([]*Event, 0, len(b.Events))
+	for _, e := range b.Events {
+		events = append(events, &Event{
+			Type:      e.Type,
+			Network:   b.Network,
+			Round:     b.Round,
+			Timestamp: b.Timestamp,
+			Offset:    e.Offset,
+			TxID:      e.TxID,
+			Addresses: e.Addresses,
+			AssetID:   e.AssetID,
+			AppID:     e.AppID,
+			Payload:   []byte(e.Payload),
+		})
+	}
+
+	return b.Round, events, nil
+}
+
+// GroupByChannel groups events by their event channels in order.
+// With byID, asset and application transactions are also added to the channels of their assets and applications.
+func GroupByChannel(prefix string, events []*Event, byID bool) ([]string, map[string][]*Event) {
+	var channels []string
+	groups := make(map[string][]*Event)
+	add := func(channel string, e *Event) {
+		if _, exist := groups[channel]; !exist {
+			channels = append(channels, channel)
+		}
+
+		groups[channel] = append(groups[channel], e)
+	}
+
+	for _, e := range events {
+		add(Channel(prefix, e.Type), e)
+		if !byID {
+			continue
+		}
+
+		if e.AssetID != 0 {
+			add(AssetChannel(prefix, e.Type, e.AssetID), e)
+		}
+
+		if e.AppID != 0 {
+			add(AppChannel(prefix, e.Type, e.AppID), e)
+		}
+	}
+
+	return channels, groups
+}
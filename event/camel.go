@@ -0,0 +1,275 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iancoleman/strcase"
+)
+
+// maxCachedKeys bounds the key cache, keys of payloads come from a fixed set of model fields so it is rarely reached
+const maxCachedKeys = 4096
+
+var errInvalidJSON = errors.New("event: invalid json")
+
+// keys caches camel case keys by their raw quoted keys
+var keys = struct {
+	mu sync.RWMutex
+	m  map[string]camelKey
+}{
+	m: make(map[string]camelKey),
+}
+
+// camelKey is a camel case key and its quoted form
+type camelKey struct {
+	name    string
+	encoded []byte
+}
+
+// member is a converted member of an object
+type member struct {
+	key   camelKey
+	value []byte
+}
+
+// convertKeys converts keys of objects to camel case in one pass without decoding the values.
+// Members are sorted by their keys, and other values are compacted and HTML escaped like json.Marshal does, so the
+// output is the same as unmarshaling every nested object into a map[string]json.RawMessage and marshaling it again.
+// Payloads are produced by json.Marshal, so values other than objects are only scanned for their end instead of being
+// validated again. A malformed object is returned as it is.
+func convertKeys(data []byte) []byte {
+	i := skipSpace(data, 0)
+	if i == len(data) || data[i] != '{' {
+		return data
+	}
+
+	bb, end, err := appendObject(make([]byte, 0, len(data)), data, i)
+	if err != nil || skipSpace(data, end) != len(data) {
+		return data
+	}
+
+	return bb
+}
+
+// appendObject appends a converted object starting at data[i] and returns the index after it
+func appendObject(dst, data []byte, i int) ([]byte, int, error) {
+	var members []member
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		i++
+	} else {
+		for {
+			keyEnd, err := skipString(data, i)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			key, err := toCamelKey(data[i:keyEnd])
+			if err != nil {
+				return nil, 0, err
+			}
+
+			i = skipSpace(data, keyEnd)
+			if i == len(data) || data[i] != ':' {
+				return nil, 0, errInvalidJSON
+			}
+
+			i = skipSpace(data, i+1)
+			var value []byte
+			if i < len(data) && data[i] == '{' {
+				value, i, err = appendObject(nil, data, i)
+			} else {
+				var end int
+				if end, err = skipValue(data, i); err == nil {
+					value, err = appendValue(nil, data[i:end])
+					i = end
+				}
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+
+			members = append(members, member{key: key, value: value})
+
+			i = skipSpace(data, i)
+			if i == len(data) {
+				return nil, 0, errInvalidJSON
+			}
+
+			if data[i] == ',' {
+				i = skipSpace(data, i+1)
+
+				continue
+			}
+
+			if data[i] != '}' {
+				return nil, 0, errInvalidJSON
+			}
+
+			i++
+
+			break
+		}
+	}
+
+	// the last one of duplicated keys wins like in a map
+	sort.SliceStable(members, func(a, b int) bool {
+		return members[a].key.name < members[b].key.name
+	})
+
+	dst = append(dst, '{')
+	first := true
+	for idx, m := range members {
+		if idx+1 < len(members) && members[idx+1].key.name == m.key.name {
+			continue
+		}
+
+		if !first {
+			dst = append(dst, ',')
+		}
+		first = false
+
+		dst = append(dst, m.key.encoded...)
+		dst = append(dst, ':')
+		dst = append(dst, m.value...)
+	}
+	dst = append(dst, '}')
+
+	return dst, i, nil
+}
+
+// appendValue appends a value that is not an object, compacted and HTML escaped like json.Marshal does
+func appendValue(dst, value []byte) ([]byte, error) {
+	if bytes.IndexAny(value, " \t\n\r<>&\u2028\u2029") < 0 {
+		return append(dst, value...), nil
+	}
+
+	var compacted, escaped bytes.Buffer
+	if err := json.Compact(&compacted, value); err != nil {
+		return nil, err
+	}
+
+	json.HTMLEscape(&escaped, compacted.Bytes())
+
+	return append(dst, escaped.Bytes()...), nil
+}
+
+// toCamelKey converts a raw quoted key to camel case
+func toCamelKey(raw []byte) (camelKey, error) {
+	keys.mu.RLock()
+	key, ok := keys.m[string(raw)]
+	keys.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return camelKey{}, err
+	}
+
+	name := strcase.ToLowerCamel(s)
+	encoded, err := json.Marshal(name)
+	if err != nil {
+		return camelKey{}, err
+	}
+
+	key = camelKey{name: name, encoded: encoded}
+
+	keys.mu.Lock()
+	if len(keys.m) < maxCachedKeys {
+		keys.m[string(raw)] = key
+	}
+	keys.mu.Unlock()
+
+	return key, nil
+}
+
+// skipValue returns the index after a value starting at data[i], nested values are not validated
+func skipValue(data []byte, i int) (int, error) {
+	if i == len(data) {
+		return 0, errInvalidJSON
+	}
+
+	switch data[i] {
+	case '"':
+		return skipString(data, i)
+	case '{', '[':
+		depth := 0
+		for i < len(data) {
+			switch data[i] {
+			case '"':
+				end, err := skipString(data, i)
+				if err != nil {
+					return 0, err
+				}
+
+				i = end
+
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return i + 1, nil
+				}
+			}
+
+			i++
+		}
+
+		return 0, errInvalidJSON
+	}
+
+	// numbers, true, false and null end at a delimiter
+	start := i
+	for i < len(data) {
+		switch data[i] {
+		case ',', '}', ']', ' ', '\t', '\n', '\r':
+			return i, nil
+		}
+
+		i++
+	}
+
+	if i == start {
+		return 0, errInvalidJSON
+	}
+
+	return i, nil
+}
+
+// skipString returns the index after a string starting at data[i]
+func skipString(data []byte, i int) (int, error) {
+	if i == len(data) || data[i] != '"' {
+		return 0, errInvalidJSON
+	}
+
+	for i++; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+
+	return 0, errInvalidJSON
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+
+	return i
+}
@@ -0,0 +1,239 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/iancoleman/strcase"
+)
+
+var (
+	indexer = flag.String("indexer", "", "an indexer URL to fetch mainnet-<round>.json fixtures from, e.g. https://mainnet-idx.algonode.cloud")
+	rounds  = flag.String("rounds", "", "comma separated rounds of fixtures fetched from -indexer")
+)
+
+// fixtures returns blocks in the format returned by the indexer (/v2/blocks/{round}). The block-*.json ones are
+// synthetic but cover every transaction type, signatures, inner transactions and state deltas, the mainnet-*.json ones
+// are real blocks fetched with -indexer.
+func fixtures(tb testing.TB) []string {
+	tb.Helper()
+
+	names, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	if err != nil || len(names) == 0 {
+		tb.Fatalf("no fixtures found: %v", err)
+	}
+
+	for i, name := range names {
+		names[i] = filepath.Base(name)
+	}
+
+	return names
+}
+
+// TestFetchFixtures saves blocks of -rounds from -indexer as they are returned, e.g.
+// go test ./event -run TestFetchFixtures -indexer https://mainnet-idx.algonode.cloud -rounds 46000000,46000001
+func TestFetchFixtures(t *testing.T) {
+	if *indexer == "" || *rounds == "" {
+		t.Skip("-indexer and -rounds are not set")
+	}
+
+	for _, round := range strings.Split(*rounds, ",") {
+		if _, err := strconv.ParseUint(round, 10, 64); err != nil {
+			t.Fatalf("round %q is invalid", round)
+		}
+
+		res, err := http.Get(strings.TrimSuffix(*indexer, "/") + "/v2/blocks/" + round)
+		if err != nil {
+			t.Fatalf("failed to fetch the round %s: %v", round, err)
+		}
+
+		bb, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil || res.StatusCode != http.StatusOK {
+			t.Fatalf("failed to fetch the round %s: status %d, %v", round, res.StatusCode, err)
+		}
+
+		if err := os.WriteFile(filepath.Join("testdata", "mainnet-"+round+".json"), bb, 0o644); err != nil {
+			t.Fatalf("failed to save the round %s: %v", round, err)
+		}
+	}
+}
+
+// legacyConvertKeys is the map based conversion that convertKeys replaced, the output must stay byte-identical
+func legacyConvertKeys(data []byte) []byte {
+	m := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &m); err != nil {
+		return data
+	}
+
+	for k, v := range m {
+		camelized := strcase.ToLowerCamel(k)
+		delete(m, k)
+		m[camelized] = legacyConvertKeys(v)
+	}
+
+	bb, err := json.Marshal(m)
+	if err != nil {
+		return data
+	}
+
+	return bb
+}
+
+func loadFixture(tb testing.TB, name string) []byte {
+	tb.Helper()
+
+	bb, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		tb.Fatalf("failed to read a fixture: %v", err)
+	}
+
+	return bb
+}
+
+// rawPayloads returns payloads of a block before their keys are converted, as they are built by FromBlock
+func rawPayloads(tb testing.TB, data []byte) [][]byte {
+	tb.Helper()
+
+	var block models.Block
+	if err := json.Unmarshal(data, &block); err != nil {
+		tb.Fatalf("failed to parse a fixture: %v", err)
+	}
+
+	var payloads [][]byte
+	add := func(v interface{}) {
+		bb, err := json.Marshal(v)
+		if err != nil {
+			tb.Fatalf("failed to marshal a payload: %v", err)
+		}
+
+		payloads = append(payloads, bb)
+	}
+
+	blockEvent := BlockEvent{EventType: NewBlock, Data: block}
+	add(blockEvent)
+	blockEvent.EventType = NewBlockHeader
+	blockEvent.Data.Transactions = nil
+	add(blockEvent)
+	for _, tx := range block.Transactions {
+		add(NewTransactionEvent(tx))
+	}
+
+	return payloads
+}
+
+func TestConvertKeysFixtures(t *testing.T) {
+	for _, name := range fixtures(t) {
+		t.Run(name, func(t *testing.T) {
+			for i, payload := range rawPayloads(t, loadFixture(t, name)) {
+				want := legacyConvertKeys(payload)
+				if got := convertKeys(payload); !bytes.Equal(got, want) {
+					t.Fatalf("payload %d differs\ngot:  %s\nwant: %s", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertKeysEdgeCases(t *testing.T) {
+	// keys that are different but convert to the same key are left out, the legacy output depends on the map order
+	cases := []string{
+		`{}`,
+		`[]`,
+		`null`,
+		`"snake_case"`,
+		`12`,
+		` { "b-key" : 1 , "a_key" : [ {"x-y": {"z_z": []}}, 1, "s" ] } `,
+		`{"dup":1,"dup":2}`,
+		`{"escaped\"key":"v\\u0041","uni-ключ":"é","html-<tag>":"<&>"}`,
+		`{"num-e":1.5e+10,"neg":-0.25,"big":18446744073709551615,"t":true,"f":false,"n":null}`,
+		`{"nested-array":[[{"inner-key":{}}],[],[null]],"empty-object":{}}`,
+		`{"unterminated":`,
+		`{"a":1}trailing`,
+		`{"a":1,}`,
+	}
+
+	for _, c := range cases {
+		want := legacyConvertKeys([]byte(c))
+		if got := convertKeys([]byte(c)); !bytes.Equal(got, want) {
+			t.Errorf("%s\ngot:  %s\nwant: %s", c, got, want)
+		}
+	}
+}
+
+func TestParseFixtures(t *testing.T) {
+	for _, name := range fixtures(t) {
+		t.Run(name, func(t *testing.T) {
+			data := loadFixture(t, name)
+			events, err := Parse(data)
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+
+			payloads := rawPayloads(t, data)
+			if len(events) != len(payloads) {
+				t.Fatalf("got %d events, want %d", len(events), len(payloads))
+			}
+
+			for i, e := range events {
+				if want := legacyConvertKeys(payloads[i]); !bytes.Equal(e.Payload, want) {
+					t.Fatalf("event %d of type %s differs", i, e.Type)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	for _, name := range fixtures(b) {
+		data := loadFixture(b, name)
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := Parse(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkConvertKeys(b *testing.B) {
+	converters := []struct {
+		name    string
+		convert func([]byte) []byte
+	}{
+		{"single-pass", convertKeys},
+		{"legacy", legacyConvertKeys},
+	}
+
+	for _, name := range fixtures(b) {
+		payloads := rawPayloads(b, loadFixture(b, name))
+		size := 0
+		for _, p := range payloads {
+			size += len(p)
+		}
+
+		for _, c := range converters {
+			b.Run(name+"/"+c.name, func(b *testing.B) {
+				b.SetBytes(int64(size))
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					for _, p := range payloads {
+						c.convert(p)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/algorand/go-codec/codec"
+)
+
+const (
+	// Version1 is the protocol version of payloads with only the event type and the data
+	Version1 = 1
+
+	// Version2 is the protocol version of payloads in an envelope with metadata of the event
+	Version2 = 2
+)
+
+// IsVersion returns true if the protocol version is supported
+func IsVersion(version int) bool {
+	return version == Version1 || version == Version2
+}
+
+// Delivery is metadata of an event that differs between deliveries
+type Delivery struct {
+	// SubscriptionID identifies the subscription that matched the event, it is omitted if empty
+	SubscriptionID string
+
+	// SentAt is a time the server sent the event
+	SentAt time.Time
+}
+
+// envelope is a payload of the protocol version 2, the data is encoded once and embedded as it is
+type envelope struct {
+	Version        int       `codec:"version"`
+	EventType      string    `codec:"eventType"`
+	Network        string    `codec:"network,omitempty"`
+	Round          uint64    `codec:"round"`
+	Timestamp      uint64    `codec:"timestamp"`
+	Offset         uint64    `codec:"offset"`
+	Seq            uint64    `codec:"seq,omitempty"`
+	SentAt         int64     `codec:"sentAt"`
+	SubscriptionID string    `codec:"subscriptionId,omitempty"`
+	Data           codec.Raw `codec:"data"`
+}
+
+// EncodeEnvelope returns the payload in an envelope of the protocol version 2 with the fields selected by a projection,
+// p may be nil to keep every field. The data is encoded once per event, format and projection.
+func (e *Event) EncodeEnvelope(format string, p *Projection, d Delivery) ([]byte, error) {
+	data, err := e.encodeData(format, p)
+	if err != nil {
+		return nil, err
+	}
+
+	env := envelope{
+		Version:        Version2,
+		EventType:      e.Type,
+		Network:        e.Network,
+		Round:          e.Round,
+		Timestamp:      e.Timestamp,
+		Offset:         e.Offset,
+		Seq:            e.Seq,
+		SentAt:         d.SentAt.UnixMilli(),
+		SubscriptionID: d.SubscriptionID,
+		Data:           data,
+	}
+
+	h := codec.Handle(jsonHandle)
+	if format != FormatJSON {
+		if h, err = handle(format); err != nil {
+			return nil, err
+		}
+	}
+
+	var bb []byte
+	if err := codec.NewEncoderBytes(&bb, h).Encode(env); err != nil {
+		return nil, err
+	}
+
+	return bb, nil
+}
+
+// encodeData returns the data of the payload in a wire format with the fields selected by a projection
+func (e *Event) encodeData(format string, p *Projection) ([]byte, error) {
+	v, err := e.Cached("data|"+EncodingKey(format, p), func() (interface{}, error) {
+		var payload struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			return nil, err
+		}
+
+		data := payload.Data
+		if p != nil {
+			var err error
+			if data, err = p.root.project(data); err != nil {
+				return nil, err
+			}
+		}
+
+		if format == FormatJSON {
+			return []byte(data), nil
+		}
+
+		return encode(data, format)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]byte), nil
+}
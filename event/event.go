@@ -2,15 +2,18 @@ package event
 
 import (
 	"encoding/json"
+	"sync"
 
 	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
-	"github.com/iancoleman/strcase"
 )
 
 const (
 	// NewBlock is the event for a new block
 	NewBlock = "NEW_BLOCK"
 
+	// NewBlockHeader is the event for a new block without its transactions
+	NewBlockHeader = "NEW_BLOCK_HEADER"
+
 	// NewPaymentTx is the event for payment transactions
 	NewPaymentTx = "NEW_PAYMENT_TX"
 
@@ -37,6 +40,7 @@ var (
 	// AllEvents represents all events
 	AllEvents = []string{
 		NewBlock,
+		NewBlockHeader,
 		NewPaymentTx,
 		NewKeyRegistrationTx,
 		NewAssetConfigTx,
@@ -49,21 +53,100 @@ var (
 
 // Event represents an event
 type Event struct {
-	Type    string
-	Payload []byte
+	Type string
+	// Seq is a sequence number assigned by the store of this server in the order events are received,
+	// replicas number events independently so only the round and the offset identify an event across them
+	Seq       uint64
+	Network   string
+	Round     uint64
+	Timestamp uint64
+	// Offset is a position of the event in its round, starting from the block event
+	Offset    uint64
+	TxID      string
+	Addresses []string
+	AssetID   uint64
+	AppID     uint64
+	Payload   []byte
+
+	mu    sync.Mutex
+	cache map[string]interface{}
+	// uncached is true if derived values are computed on every call instead of being kept with the event
+	uncached bool
+}
+
+// Uncached returns a copy of the event that does not keep derived values, e.g. encodings.
+// Events kept for a long time are copied, so that encodings only live as long as they are dispatched.
+func (e *Event) Uncached() *Event {
+	return &Event{
+		Type:      e.Type,
+		Seq:       e.Seq,
+		Network:   e.Network,
+		Round:     e.Round,
+		Timestamp: e.Timestamp,
+		Offset:    e.Offset,
+		TxID:      e.TxID,
+		Addresses: e.Addresses,
+		AssetID:   e.AssetID,
+		AppID:     e.AppID,
+		Payload:   e.Payload,
+		uncached:  true,
+	}
+}
+
+// Cached returns a value derived from the event, fn is called only once for each key unless the event is uncached
+func (e *Event) Cached(key string, fn func() (interface{}, error)) (interface{}, error) {
+	if e.uncached {
+		return fn()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if v, ok := e.cache[key]; ok {
+		return v, nil
+	}
+
+	v, err := fn()
+	if err != nil {
+		return nil, err
+	}
+
+	if e.cache == nil {
+		e.cache = make(map[string]interface{})
+	}
+
+	e.cache[key] = v
+
+	return v, nil
+}
+
+// HasAddress returns true if the event involves the given address
+func (e *Event) HasAddress(address string) bool {
+	for _, a := range e.Addresses {
+		if a == address {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Parse raw data to an event
 func Parse(data []byte) ([]*Event, error) {
-	var events []*Event
 	var block models.Block
 	if err := json.Unmarshal(data, &block); err != nil {
 		return nil, err
 	}
 
+	return FromBlock(&block)
+}
+
+// FromBlock converts a block to a block event and a block header event followed by an event for each transaction
+func FromBlock(block *models.Block) ([]*Event, error) {
+	var events []*Event
 	blockEvent := BlockEvent{
 		EventType: NewBlock,
-		Data:      block,
+		Data:      *block,
 	}
 
 	payload, err := json.Marshal(blockEvent)
@@ -72,11 +155,30 @@ func Parse(data []byte) ([]*Event, error) {
 	}
 
 	events = append(events, &Event{
-		Type:    NewBlock,
-		Payload: convertKeys(payload),
+		Type:      NewBlock,
+		Network:   block.GenesisId,
+		Round:     block.Round,
+		Timestamp: block.Timestamp,
+		Payload:   convertKeys(payload),
 	})
 
-	for _, tx := range block.Transactions {
+	// transactions are omitted from the header, it has the offset of the block it is a view of
+	blockEvent.EventType = NewBlockHeader
+	blockEvent.Data.Transactions = nil
+	payload, err = json.Marshal(blockEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	events = append(events, &Event{
+		Type:      NewBlockHeader,
+		Network:   block.GenesisId,
+		Round:     block.Round,
+		Timestamp: block.Timestamp,
+		Payload:   convertKeys(payload),
+	})
+
+	for i, tx := range block.Transactions {
 		txEvent := NewTransactionEvent(tx)
 		payload, err := json.Marshal(txEvent)
 		if err != nil {
@@ -84,31 +186,18 @@ func Parse(data []byte) ([]*Event, error) {
 		}
 
 		events = append(events, &Event{
-			Type:    txEvent.EventType,
-			Payload: convertKeys(payload),
+			Type:      txEvent.EventType,
+			Network:   block.GenesisId,
+			Round:     block.Round,
+			Timestamp: block.Timestamp,
+			Offset:    uint64(i) + 1,
+			TxID:      tx.Id,
+			Addresses: transactionAddresses(tx),
+			AssetID:   transactionAssetID(tx),
+			AppID:     transactionAppID(tx),
+			Payload:   convertKeys(payload),
 		})
 	}
 
 	return events, nil
 }
-
-// convertKeys converts keys to camel case
-func convertKeys(data []byte) []byte {
-	m := make(map[string]json.RawMessage)
-	if err := json.Unmarshal(data, &m); err != nil {
-		return data
-	}
-
-	for k, v := range m {
-		camelized := strcase.ToLowerCamel(k)
-		delete(m, k)
-		m[camelized] = convertKeys(v)
-	}
-
-	bb, err := json.Marshal(m)
-	if err != nil {
-		return data
-	}
-
-	return bb
-}
@@ -0,0 +1,64 @@
+package event
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUncached(t *testing.T) {
+	e := &Event{
+		Type:      NewPaymentTx,
+		Seq:       1,
+		Network:   "mainnet-v1.0",
+		Round:     2,
+		Timestamp: 3,
+		Offset:    4,
+		TxID:      "TX",
+		Addresses: []string{"A", "B"},
+		AssetID:   5,
+		AppID:     6,
+		Payload:   []byte(`{"eventType":"NEW_PAYMENT_TX"}`),
+	}
+
+	u := e.Uncached()
+
+	// every exported field is copied
+	ev, uv := reflect.ValueOf(e).Elem(), reflect.ValueOf(u).Elem()
+	for i := 0; i < ev.NumField(); i++ {
+		field := ev.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if !reflect.DeepEqual(ev.Field(i).Interface(), uv.Field(i).Interface()) {
+			t.Errorf("%s was not copied", field.Name)
+		}
+	}
+
+	calls := 0
+	fn := func() (interface{}, error) {
+		calls++
+
+		return calls, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := u.Cached("key", fn); err != nil {
+			t.Fatalf("failed to compute a value: %v", err)
+		}
+	}
+
+	if calls != 2 || len(u.cache) != 0 {
+		t.Fatalf("an uncached event computed %d times and kept %d values", calls, len(u.cache))
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := e.Cached("key", fn); err != nil {
+			t.Fatalf("failed to compute a value: %v", err)
+		}
+	}
+
+	if calls != 3 {
+		t.Fatalf("a cached event computed %d times", calls-2)
+	}
+}
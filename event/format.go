@@ -0,0 +1,124 @@
+package event
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/algorand/go-codec/codec"
+)
+
+const (
+	// FormatJSON sends payloads as they are in JSON text frames
+	FormatJSON = "json"
+
+	// FormatMsgpack sends payloads encoded with MessagePack in binary frames
+	FormatMsgpack = "msgpack"
+
+	// FormatCBOR sends payloads encoded with CBOR in binary frames
+	FormatCBOR = "cbor"
+)
+
+var (
+	// Formats represents all wire formats, the first one is the default
+	Formats = []string{
+		FormatJSON,
+		FormatMsgpack,
+		FormatCBOR,
+	}
+
+	jsonHandle    = &codec.JsonHandle{}
+	msgpackHandle = &codec.MsgpackHandle{WriteExt: true}
+	cborHandle    = &codec.CborHandle{}
+)
+
+func init() {
+	// keys are sorted, so that every encoding of the same payload is identical.
+	// Raw values let encoded events be embedded in a round envelope without decoding them.
+	jsonHandle.MapType = reflect.TypeOf(map[string]interface{}(nil))
+	jsonHandle.Raw = true
+	msgpackHandle.Canonical = true
+	msgpackHandle.Raw = true
+	cborHandle.Canonical = true
+	cborHandle.Raw = true
+}
+
+// IsFormat returns true if the format is supported
+func IsFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Encode returns the payload in a wire format with the fields selected by a projection, p may be nil to keep every field.
+// The encoding is done once per event, format and projection.
+func (e *Event) Encode(format string, p *Projection) ([]byte, error) {
+	if format == FormatJSON && p == nil {
+		return e.Payload, nil
+	}
+
+	v, err := e.Cached(EncodingKey(format, p), func() (interface{}, error) {
+		payload := e.Payload
+		if p != nil {
+			var err error
+			if payload, err = p.apply(payload); err != nil {
+				return nil, err
+			}
+		}
+
+		if format == FormatJSON {
+			return payload, nil
+		}
+
+		return encode(payload, format)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.([]byte), nil
+}
+
+// EncodingKey returns a key identifying an encoding of a format and a projection, p may be nil
+func EncodingKey(format string, p *Projection) string {
+	if p == nil {
+		return format
+	}
+
+	return format + "|" + p.Key()
+}
+
+// handle returns a codec handle of a binary format
+func handle(format string) (codec.Handle, error) {
+	switch format {
+	case FormatMsgpack:
+		return msgpackHandle, nil
+	case FormatCBOR:
+		return cborHandle, nil
+	}
+
+	return nil, fmt.Errorf("event: unsupported format %s", format)
+}
+
+// encode transcodes a JSON payload, integers are kept as integers instead of floats
+func encode(payload []byte, format string) ([]byte, error) {
+	h, err := handle(format)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := codec.NewDecoderBytes(payload, jsonHandle).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var bb []byte
+	if err := codec.NewEncoderBytes(&bb, h).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bb, nil
+}
@@ -0,0 +1,167 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/algorand/go-codec/codec"
+)
+
+// decodeFormat decodes an encoded payload to the values encoding/json would decode, with numbers as json.Number
+func decodeFormat(t *testing.T, format string, data []byte) interface{} {
+	t.Helper()
+
+	var h codec.Handle
+	switch format {
+	case FormatMsgpack:
+		h = &codec.MsgpackHandle{RawToString: true}
+	case FormatCBOR:
+		h = &codec.CborHandle{}
+	default:
+		return decodeJSON(t, data)
+	}
+
+	var v interface{}
+	if err := codec.NewDecoderBytes(data, h).Decode(&v); err != nil {
+		t.Fatalf("failed to decode %s: %v", format, err)
+	}
+
+	bb, err := json.Marshal(normalize(v))
+	if err != nil {
+		t.Fatalf("failed to marshal a decoded value: %v", err)
+	}
+
+	return decodeJSON(t, bb)
+}
+
+func decodeJSON(t *testing.T, data []byte) interface{} {
+	t.Helper()
+
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		t.Fatalf("failed to decode json: %v", err)
+	}
+
+	return v
+}
+
+// normalize converts maps with interface keys decoded by codec to maps that encoding/json can marshal
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, value := range t {
+			m[k.(string)] = normalize(value)
+		}
+
+		return m
+	case []interface{}:
+		for i := range t {
+			t[i] = normalize(t[i])
+		}
+	}
+
+	return v
+}
+
+func TestEncodeFormats(t *testing.T) {
+	payloads := []struct {
+		name    string
+		payload string
+	}{
+		{name: "flat", payload: `{"eventType":"NEW_PAYMENT_TX","data":{"fee":1000,"sender":"ABC"}}`},
+		{name: "large integers", payload: `{"data":{"amount":18446744073709551615,"round":46000000,"negative":-5}}`},
+		{name: "nested", payload: `{"data":{"innerTxns":[{"fee":0,"note":"bm90ZQ=="},{"logs":["YQ==",null]}],"ok":true,"empty":{}}}`},
+		{name: "unicode", payload: `{"data":{"note":"héllo   <tag> & \"quoted\""}}`},
+	}
+
+	for _, format := range Formats {
+		for _, tc := range payloads {
+			t.Run(format+"/"+tc.name, func(t *testing.T) {
+				e := &Event{Type: NewPaymentTx, Payload: []byte(tc.payload)}
+				bb, err := e.Encode(format, nil)
+				if err != nil {
+					t.Fatalf("failed to encode: %v", err)
+				}
+
+				if got, want := decodeFormat(t, format, bb), decodeJSON(t, e.Payload); !reflect.DeepEqual(got, want) {
+					t.Fatalf("decoded payload differs\ngot:  %v\nwant: %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestEncodeSortsKeys(t *testing.T) {
+	tests := []struct {
+		format string
+		want   []byte
+	}{
+		// {"a":"x","b":1} as a fixmap of two fixstr keys
+		{format: FormatMsgpack, want: []byte{0x82, 0xa1, 'a', 0xa1, 'x', 0xa1, 'b', 0x01}},
+		{format: FormatCBOR, want: []byte{0xa2, 0x61, 'a', 0x61, 'x', 0x61, 'b', 0x01}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			for _, payload := range []string{`{"b":1,"a":"x"}`, `{"a":"x","b":1}`} {
+				bb, err := (&Event{Payload: []byte(payload)}).Encode(tc.format, nil)
+				if err != nil {
+					t.Fatalf("failed to encode: %v", err)
+				}
+
+				if !bytes.Equal(bb, tc.want) {
+					t.Fatalf("%s was encoded as %x, want %x", payload, bb, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeCache(t *testing.T) {
+	e := &Event{Payload: []byte(`{"a":1}`)}
+
+	// JSON payloads without a projection are sent as they are
+	if bb, _ := e.Encode(FormatJSON, nil); &bb[0] != &e.Payload[0] {
+		t.Fatal("a JSON payload was copied")
+	}
+
+	first, err := e.Encode(FormatMsgpack, nil)
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+
+	second, _ := e.Encode(FormatMsgpack, nil)
+	if &first[0] != &second[0] {
+		t.Fatal("the payload was encoded twice")
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		payload string
+	}{
+		{name: "unsupported format", format: "xml", payload: `{"a":1}`},
+		{name: "invalid payload", format: FormatMsgpack, payload: `{"a":`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := (&Event{Payload: []byte(tc.payload)}).Encode(tc.format, nil); err == nil {
+				t.Fatal("an encoding error was not returned")
+			}
+		})
+	}
+
+	for format, want := range map[string]bool{FormatJSON: true, FormatMsgpack: true, FormatCBOR: true, "xml": false, "": false} {
+		if IsFormat(format) != want {
+			t.Errorf("IsFormat(%q) = %v", format, !want)
+		}
+	}
+}
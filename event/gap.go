@@ -0,0 +1,41 @@
+package event
+
+import (
+	"encoding/json"
+)
+
+// GapDetected is the control message sent to every client when rounds were skipped, clients do not subscribe to it
+const GapDetected = "GAP_DETECTED"
+
+// GapEvent represents a gap detected event
+type GapEvent struct {
+	EventType string  `json:"eventType"`
+	Data      GapData `json:"data"`
+}
+
+// GapData represents skipped rounds
+type GapData struct {
+	FromRound uint64 `json:"fromRound"`
+	ToRound   uint64 `json:"toRound"`
+}
+
+// NewGapDetected creates a control event for rounds from fromRound to toRound that were skipped.
+// Its payload is only encoded as version 1 JSON, it is not sent to gRPC clients.
+func NewGapDetected(fromRound, toRound uint64) (*Event, error) {
+	payload, err := json.Marshal(GapEvent{
+		EventType: GapDetected,
+		Data: GapData{
+			FromRound: fromRound,
+			ToRound:   toRound,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		Type:    GapDetected,
+		Round:   toRound + 1,
+		Payload: payload,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MaxProjectionFields is a maximum number of fields of a projection
+const MaxProjectionFields = 64
+
+// Projection selects fields of the data of event payloads, nested fields are separated by dots.
+// Fields of objects in arrays are selected from every object, e.g. transactions.id of NEW_BLOCK.
+type Projection struct {
+	key  string
+	root projectionNode
+}
+
+// projectionNode maps selected keys of an object to the fields selected from their values, nil selects a whole value
+type projectionNode map[string]projectionNode
+
+// NewProjection creates a projection of fields, e.g. id, sender or paymentTransaction.amount
+func NewProjection(fields []string) (*Projection, error) {
+	if len(fields) == 0 || len(fields) > MaxProjectionFields {
+		return nil, fmt.Errorf("event: a projection must have 1 to %d fields", MaxProjectionFields)
+	}
+
+	sorted := append([]string(nil), fields...)
+	sort.Strings(sorted)
+
+	root := make(projectionNode)
+	var unique []string
+	for idx, field := range sorted {
+		if idx > 0 && field == sorted[idx-1] {
+			continue
+		}
+
+		unique = append(unique, field)
+		segments := strings.Split(field, ".")
+		node := root
+		for i, segment := range segments {
+			if segment == "" {
+				return nil, fmt.Errorf("event: field %q is invalid", field)
+			}
+
+			child, exist := node[segment]
+			if exist && child == nil {
+				// a parent field already selects the whole value
+				break
+			}
+
+			if i == len(segments)-1 {
+				node[segment] = nil
+
+				break
+			}
+
+			if !exist {
+				child = make(projectionNode)
+				node[segment] = child
+			}
+
+			node = child
+		}
+	}
+
+	return &Projection{
+		key:  strings.Join(unique, ","),
+		root: root,
+	}, nil
+}
+
+// Key returns a key identifying the projection, projections of the same fields have the same key
+func (p *Projection) Key() string {
+	return p.key
+}
+
+// apply returns a payload with only the selected fields of its data
+func (p *Projection) apply(payload []byte) ([]byte, error) {
+	m := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(payload, &m); err != nil {
+		return nil, err
+	}
+
+	data, exist := m["data"]
+	if !exist {
+		return payload, nil
+	}
+
+	projected, err := p.root.project(data)
+	if err != nil {
+		return nil, err
+	}
+
+	m["data"] = projected
+
+	return json.Marshal(m)
+}
+
+// project selects fields of an object or of every object in an array, other values are returned as they are
+func (n projectionNode) project(raw json.RawMessage) (json.RawMessage, error) {
+	s := strings.TrimSpace(string(raw))
+	if strings.HasPrefix(s, "[") {
+		var values []json.RawMessage
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, err
+		}
+
+		for i, v := range values {
+			projected, err := n.project(v)
+			if err != nil {
+				return nil, err
+			}
+
+			values[i] = projected
+		}
+
+		return json.Marshal(values)
+	}
+
+	if !strings.HasPrefix(s, "{") {
+		return raw, nil
+	}
+
+	m := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]json.RawMessage, len(n))
+	for key, child := range n {
+		v, exist := m[key]
+		if !exist {
+			continue
+		}
+
+		if child == nil {
+			out[key] = v
+
+			continue
+		}
+
+		projected, err := child.project(v)
+		if err != nil {
+			return nil, err
+		}
+
+		out[key] = projected
+	}
+
+	return json.Marshal(out)
+}
@@ -0,0 +1,145 @@
+package event
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNewProjection(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []string
+		key     string
+		invalid bool
+	}{
+		{name: "single field", fields: []string{"id"}, key: "id"},
+		{name: "sorted and deduplicated", fields: []string{"sender", "id", "sender"}, key: "id,sender"},
+		{name: "nested fields", fields: []string{"paymentTransaction.amount", "id"}, key: "id,paymentTransaction.amount"},
+		{name: "no fields", fields: nil, invalid: true},
+		{name: "empty field", fields: []string{""}, invalid: true},
+		{name: "empty segment", fields: []string{"paymentTransaction..amount"}, invalid: true},
+		{name: "trailing dot", fields: []string{"paymentTransaction."}, invalid: true},
+		{name: "too many fields", fields: manyFields(MaxProjectionFields + 1), invalid: true},
+		{name: "as many fields as allowed", fields: manyFields(MaxProjectionFields), key: strings.Join(sortedFields(MaxProjectionFields), ",")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewProjection(tc.fields)
+			if tc.invalid {
+				if err == nil {
+					t.Fatal("an invalid projection was created")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to create a projection: %v", err)
+			}
+
+			if p.Key() != tc.key {
+				t.Fatalf("key is %q, want %q", p.Key(), tc.key)
+			}
+		})
+	}
+}
+
+func manyFields(n int) []string {
+	fields := make([]string, n)
+	for i := range fields {
+		fields[i] = "f" + strconv.Itoa(i)
+	}
+
+	return fields
+}
+
+func sortedFields(n int) []string {
+	fields := manyFields(n)
+	sort.Strings(fields)
+
+	return fields
+}
+
+func TestProjectionApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []string
+		payload string
+		want    string
+	}{
+		{
+			name:    "top level fields",
+			fields:  []string{"id", "fee"},
+			payload: `{"eventType":"NEW_PAYMENT_TX","data":{"id":"TX","fee":1000,"sender":"A"}}`,
+			want:    `{"data":{"fee":1000,"id":"TX"},"eventType":"NEW_PAYMENT_TX"}`,
+		},
+		{
+			name:    "nested field",
+			fields:  []string{"paymentTransaction.amount"},
+			payload: `{"eventType":"NEW_PAYMENT_TX","data":{"id":"TX","paymentTransaction":{"amount":5,"receiver":"B"}}}`,
+			want:    `{"data":{"paymentTransaction":{"amount":5}},"eventType":"NEW_PAYMENT_TX"}`,
+		},
+		{
+			name:    "parent field selects the whole value",
+			fields:  []string{"paymentTransaction", "paymentTransaction.amount"},
+			payload: `{"data":{"paymentTransaction":{"amount":5,"receiver":"B"}}}`,
+			want:    `{"data":{"paymentTransaction":{"amount":5,"receiver":"B"}}}`,
+		},
+		{
+			name:    "fields of objects in arrays",
+			fields:  []string{"round", "transactions.id"},
+			payload: `{"eventType":"NEW_BLOCK","data":{"round":7,"transactions":[{"id":"A","fee":1},{"id":"B","fee":2},{"fee":3}]}}`,
+			want:    `{"data":{"round":7,"transactions":[{"id":"A"},{"id":"B"},{}]},"eventType":"NEW_BLOCK"}`,
+		},
+		{
+			name:    "missing fields are omitted",
+			fields:  []string{"assetTransferTransaction.amount", "id"},
+			payload: `{"data":{"id":"TX"}}`,
+			want:    `{"data":{"id":"TX"}}`,
+		},
+		{
+			name:    "nested field of a scalar",
+			fields:  []string{"fee.amount"},
+			payload: `{"data":{"fee":1000}}`,
+			want:    `{"data":{"fee":1000}}`,
+		},
+		{
+			name:    "payload without data",
+			fields:  []string{"id"},
+			payload: `{"eventType":"GAP_DETECTED"}`,
+			want:    `{"eventType":"GAP_DETECTED"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewProjection(tc.fields)
+			if err != nil {
+				t.Fatalf("failed to create a projection: %v", err)
+			}
+
+			got, err := (&Event{Payload: []byte(tc.payload)}).Encode(FormatJSON, p)
+			if err != nil {
+				t.Fatalf("failed to apply the projection: %v", err)
+			}
+
+			if string(got) != tc.want {
+				t.Fatalf("got  %s\nwant %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestProjectionInvalidPayload(t *testing.T) {
+	p, err := NewProjection([]string{"id"})
+	if err != nil {
+		t.Fatalf("failed to create a projection: %v", err)
+	}
+
+	if _, err := (&Event{Payload: []byte(`{"data":`)}).Encode(FormatJSON, p); err == nil {
+		t.Fatal("an invalid payload was projected")
+	}
+}
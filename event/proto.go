@@ -0,0 +1,98 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	notificationv1 "github.com/synycboom/algorand-notification/proto/notification/v1"
+)
+
+const protoCacheKey = "proto"
+
+// Proto converts the event to a typed protobuf message, the conversion is done once per event
+func (e *Event) Proto() (*notificationv1.Event, error) {
+	v, err := e.Cached(protoCacheKey, func() (interface{}, error) {
+		return toProto(e)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*notificationv1.Event), nil
+}
+
+func toProto(e *Event) (*notificationv1.Event, error) {
+	var payload struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return nil, err
+	}
+
+	data, err := camelizeKeys(payload.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &notificationv1.Event{
+		EventType: e.Type,
+		Seq:       e.Seq,
+		Round:     e.Round,
+	}
+	opts := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if e.Type == NewBlock || e.Type == NewBlockHeader {
+		var block notificationv1.Block
+		if err := opts.Unmarshal(data, &block); err != nil {
+			return nil, err
+		}
+
+		msg.Data = &notificationv1.Event_Block{Block: &block}
+
+		return msg, nil
+	}
+
+	var tx notificationv1.Transaction
+	if err := opts.Unmarshal(data, &tx); err != nil {
+		return nil, err
+	}
+
+	msg.Data = &notificationv1.Event_Transaction{Transaction: &tx}
+
+	return msg, nil
+}
+
+// camelizeKeys converts keys to camel case like convertKeys, but also descends into arrays
+// so that nested transactions match protobuf json names
+func camelizeKeys(data []byte) ([]byte, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(camelize(v))
+}
+
+func camelize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, value := range t {
+			m[strcase.ToLowerCamel(k)] = camelize(value)
+		}
+
+		return m
+	case []interface{}:
+		for i := range t {
+			t[i] = camelize(t[i])
+		}
+
+		return t
+	}
+
+	return v
+}
@@ -0,0 +1,189 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+
+	notificationv1 "github.com/synycboom/algorand-notification/proto/notification/v1"
+)
+
+func TestProtoFixtures(t *testing.T) {
+	for _, name := range fixtures(t) {
+		t.Run(name, func(t *testing.T) {
+			data := loadFixture(t, name)
+			var block models.Block
+			if err := json.Unmarshal(data, &block); err != nil {
+				t.Fatalf("failed to parse a fixture: %v", err)
+			}
+
+			events, err := Parse(data)
+			if err != nil {
+				t.Fatalf("failed to parse events: %v", err)
+			}
+
+			for i, e := range events {
+				e.Seq = uint64(i + 1)
+				msg, err := e.Proto()
+				if err != nil {
+					t.Fatalf("failed to convert the event %d: %v", i, err)
+				}
+
+				if msg.EventType != e.Type || msg.Seq != e.Seq || msg.Round != block.Round {
+					t.Fatalf("event %d has the metadata %s/%v/%v", i, msg.EventType, msg.Seq, msg.Round)
+				}
+
+				switch e.Type {
+				case NewBlock, NewBlockHeader:
+					b := msg.GetBlock()
+					want := len(block.Transactions)
+					if e.Type == NewBlockHeader {
+						want = 0
+					}
+
+					if b.GetRound() != block.Round || b.GetGenesisId() != block.GenesisId || b.GetTimestamp() != block.Timestamp ||
+						!bytes.Equal(b.GetGenesisHash(), block.GenesisHash) || len(b.GetTransactions()) != want {
+						t.Fatalf("%s does not match the block", e.Type)
+					}
+				default:
+					compareTx(t, msg.GetTransaction(), block.Transactions[e.Offset-1])
+				}
+			}
+		})
+	}
+}
+
+func compareTx(t *testing.T, got *notificationv1.Transaction, want models.Transaction) {
+	t.Helper()
+
+	if got.GetId() != want.Id || got.GetSender() != want.Sender || got.GetFee() != want.Fee ||
+		got.GetTxType() != want.Type || !bytes.Equal(got.GetNote(), want.Note) {
+		t.Fatalf("transaction %s does not match", want.Id)
+	}
+}
+
+func TestProtoTransactionTypes(t *testing.T) {
+	tests := []struct {
+		name  string
+		tx    models.Transaction
+		check func(t *testing.T, e *Event)
+	}{
+		{
+			name: "payment",
+			tx: models.Transaction{
+				Id:                 "PAY",
+				Type:               "pay",
+				Sender:             "SENDER",
+				PaymentTransaction: models.TransactionPayment{Amount: 1000, Receiver: "RECEIVER"},
+			},
+			check: func(t *testing.T, e *Event) {
+				msg, err := e.Proto()
+				if err != nil {
+					t.Fatalf("failed to convert: %v", err)
+				}
+
+				pay := msg.GetTransaction().GetPaymentTransaction()
+				if pay.GetAmount() != 1000 || pay.GetReceiver() != "RECEIVER" {
+					t.Fatalf("unexpected payment %v", pay)
+				}
+			},
+		},
+		{
+			name: "asset transfer",
+			tx: models.Transaction{
+				Id:                       "AXFER",
+				Type:                     "axfer",
+				Sender:                   "SENDER",
+				AssetTransferTransaction: models.TransactionAssetTransfer{Amount: 5, AssetId: 31566704, Receiver: "RECEIVER"},
+			},
+			check: func(t *testing.T, e *Event) {
+				msg, err := e.Proto()
+				if err != nil {
+					t.Fatalf("failed to convert: %v", err)
+				}
+
+				axfer := msg.GetTransaction().GetAssetTransferTransaction()
+				if axfer.GetAmount() != 5 || axfer.GetAssetId() != 31566704 || axfer.GetReceiver() != "RECEIVER" {
+					t.Fatalf("unexpected asset transfer %v", axfer)
+				}
+			},
+		},
+		{
+			name: "application call with inner transactions",
+			tx: models.Transaction{
+				Id:                     "APPL",
+				Type:                   "appl",
+				Sender:                 "SENDER",
+				ApplicationTransaction: models.TransactionApplication{ApplicationId: 42, ForeignAssets: []uint64{1, 2}},
+				InnerTxns: []models.Transaction{
+					{Type: "pay", Sender: "APP", PaymentTransaction: models.TransactionPayment{Amount: 7, Receiver: "SENDER"}},
+				},
+			},
+			check: func(t *testing.T, e *Event) {
+				msg, err := e.Proto()
+				if err != nil {
+					t.Fatalf("failed to convert: %v", err)
+				}
+
+				tx := msg.GetTransaction()
+				appl := tx.GetApplicationTransaction()
+				if appl.GetApplicationId() != 42 || len(appl.GetForeignAssets()) != 2 {
+					t.Fatalf("unexpected application call %v", appl)
+				}
+
+				// keys of nested transactions are converted as well
+				inner := tx.GetInnerTxns()
+				if len(inner) != 1 || inner[0].GetPaymentTransaction().GetAmount() != 7 || inner[0].GetSender() != "APP" {
+					t.Fatalf("unexpected inner transactions %v", inner)
+				}
+			},
+		},
+		{
+			name: "converted once",
+			tx:   models.Transaction{Id: "PAY", Type: "pay"},
+			check: func(t *testing.T, e *Event) {
+				first, err := e.Proto()
+				if err != nil {
+					t.Fatalf("failed to convert: %v", err)
+				}
+
+				second, _ := e.Proto()
+				if first != second {
+					t.Fatal("the event was converted twice")
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := json.Marshal(NewTransactionEvent(tc.tx))
+			if err != nil {
+				t.Fatalf("failed to marshal a transaction: %v", err)
+			}
+
+			tc.check(t, &Event{Type: NewPaymentTx, Payload: convertKeys(payload)})
+		})
+	}
+}
+
+func TestProtoInvalidPayloads(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{name: "not json", payload: `{"eventType":`},
+		{name: "data is not an object", payload: `{"eventType":"NEW_PAYMENT_TX","data":1}`},
+		{name: "field of a wrong type", payload: `{"eventType":"NEW_PAYMENT_TX","data":{"fee":"high"}}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := (&Event{Type: NewPaymentTx, Payload: []byte(tc.payload)}).Proto(); err == nil {
+				t.Fatal("an invalid payload was converted")
+			}
+		})
+	}
+}
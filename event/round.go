@@ -0,0 +1,61 @@
+package event
+
+import (
+	"encoding/json"
+
+	"github.com/algorand/go-codec/codec"
+)
+
+// RoundBatch is the event type of a message with every matched event of a round
+const RoundBatch = "ROUND_BATCH"
+
+// roundEnvelope is a message with events of a round encoded in the same wire format
+type roundEnvelope struct {
+	Version        int               `json:"version,omitempty" codec:"version,omitempty"`
+	EventType      string            `json:"eventType" codec:"eventType"`
+	SubscriptionID string            `json:"subscriptionId,omitempty" codec:"subscriptionId,omitempty"`
+	Round          uint64            `json:"round" codec:"round"`
+	Timestamp      uint64            `json:"timestamp" codec:"timestamp"`
+	Events         []json.RawMessage `json:"events" codec:"-"`
+	Encoded        []codec.Raw       `json:"-" codec:"events"`
+}
+
+// EncodeRound returns a message of a round with events already encoded in the same format and protocol version,
+// the version is omitted for the protocol version 1 and subscriptionID is omitted if it is empty
+func EncodeRound(format string, version int, subscriptionID string, round, timestamp uint64, events [][]byte) ([]byte, error) {
+	env := roundEnvelope{
+		EventType:      RoundBatch,
+		SubscriptionID: subscriptionID,
+		Round:          round,
+		Timestamp:      timestamp,
+	}
+	if version != Version1 {
+		env.Version = version
+	}
+
+	if format == FormatJSON {
+		env.Events = make([]json.RawMessage, 0, len(events))
+		for _, e := range events {
+			env.Events = append(env.Events, e)
+		}
+
+		return json.Marshal(env)
+	}
+
+	h, err := handle(format)
+	if err != nil {
+		return nil, err
+	}
+
+	env.Encoded = make([]codec.Raw, 0, len(events))
+	for _, e := range events {
+		env.Encoded = append(env.Encoded, e)
+	}
+
+	var bb []byte
+	if err := codec.NewEncoderBytes(&bb, h).Encode(env); err != nil {
+		return nil, err
+	}
+
+	return bb, nil
+}
@@ -0,0 +1,77 @@
+package event
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodeRound(t *testing.T) {
+	events := [][]byte{
+		[]byte(`{"eventType":"NEW_PAYMENT_TX","data":{"id":"A"}}`),
+		[]byte(`{"eventType":"NEW_PAYMENT_TX","data":{"id":"B"}}`),
+	}
+
+	tests := []struct {
+		name           string
+		version        int
+		subscriptionID string
+		want           string
+	}{
+		{
+			name:    "version 1",
+			version: Version1,
+			want:    `{"eventType":"ROUND_BATCH","round":7,"timestamp":100,"events":[{"eventType":"NEW_PAYMENT_TX","data":{"id":"A"}},{"eventType":"NEW_PAYMENT_TX","data":{"id":"B"}}]}`,
+		},
+		{
+			name:           "version 2 with a subscription id",
+			version:        Version2,
+			subscriptionID: "3",
+			want:           `{"version":2,"eventType":"ROUND_BATCH","subscriptionId":"3","round":7,"timestamp":100,"events":[{"eventType":"NEW_PAYMENT_TX","data":{"id":"A"}},{"eventType":"NEW_PAYMENT_TX","data":{"id":"B"}}]}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bb, err := EncodeRound(FormatJSON, tc.version, tc.subscriptionID, 7, 100, events)
+			if err != nil {
+				t.Fatalf("failed to encode: %v", err)
+			}
+
+			if string(bb) != tc.want {
+				t.Fatalf("got  %s\nwant %s", bb, tc.want)
+			}
+		})
+	}
+}
+
+func TestEncodeRoundBinary(t *testing.T) {
+	for _, format := range []string{FormatMsgpack, FormatCBOR} {
+		t.Run(format, func(t *testing.T) {
+			var events [][]byte
+			for _, payload := range []string{`{"data":{"id":"A"}}`, `{"data":{"id":"B"}}`} {
+				bb, err := (&Event{Payload: []byte(payload)}).Encode(format, nil)
+				if err != nil {
+					t.Fatalf("failed to encode an event: %v", err)
+				}
+
+				events = append(events, bb)
+			}
+
+			bb, err := EncodeRound(format, Version1, "", 7, 100, events)
+			if err != nil {
+				t.Fatalf("failed to encode: %v", err)
+			}
+
+			// encoded events are embedded as values of the same format, not as byte strings
+			got := decodeFormat(t, format, bb)
+			want := decodeJSON(t, []byte(`{"eventType":"ROUND_BATCH","round":7,"timestamp":100,"events":[{"data":{"id":"A"}},{"data":{"id":"B"}}]}`))
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("got  %v\nwant %v", got, want)
+			}
+		})
+	}
+
+	if _, err := EncodeRound("xml", Version1, "", 7, 100, nil); err == nil {
+		t.Fatal("an unsupported format was encoded")
+	}
+}
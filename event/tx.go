@@ -102,3 +102,65 @@ func NewTransactionEvent(tx models.Transaction) TransactionEvent {
 		Data:      data,
 	}
 }
+
+// transactionAddresses returns unique addresses involved in a transaction
+func transactionAddresses(tx models.Transaction) []string {
+	var addresses []string
+	seen := make(map[string]struct{})
+	candidates := []string{
+		tx.Sender,
+		tx.PaymentTransaction.Receiver,
+		tx.PaymentTransaction.CloseRemainderTo,
+		tx.AssetTransferTransaction.Receiver,
+		tx.AssetTransferTransaction.CloseTo,
+		tx.AssetTransferTransaction.Sender,
+		tx.AssetFreezeTransaction.Address,
+	}
+	candidates = append(candidates, tx.ApplicationTransaction.Accounts...)
+
+	for _, address := range candidates {
+		if address == "" {
+			continue
+		}
+
+		if _, ok := seen[address]; ok {
+			continue
+		}
+
+		seen[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
+// transactionAssetID returns an asset involved in a transaction, it is 0 for non-asset transactions
+func transactionAssetID(tx models.Transaction) uint64 {
+	switch tx.Type {
+	case string(types.AssetConfigTx):
+		if tx.AssetConfigTransaction.AssetId == 0 {
+			return tx.CreatedAssetIndex
+		}
+
+		return tx.AssetConfigTransaction.AssetId
+	case string(types.AssetTransferTx):
+		return tx.AssetTransferTransaction.AssetId
+	case string(types.AssetFreezeTx):
+		return tx.AssetFreezeTransaction.AssetId
+	}
+
+	return 0
+}
+
+// transactionAppID returns an application involved in a transaction, it is 0 for non-application transactions
+func transactionAppID(tx models.Transaction) uint64 {
+	if tx.Type != string(types.ApplicationCallTx) {
+		return 0
+	}
+
+	if tx.ApplicationTransaction.ApplicationId == 0 {
+		return tx.CreatedApplicationIndex
+	}
+
+	return tx.ApplicationTransaction.ApplicationId
+}
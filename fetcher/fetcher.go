@@ -2,12 +2,14 @@ package fetcher
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/algorand/go-algorand-sdk/client/v2/common"
 	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
 	"github.com/algorand/go-algorand-sdk/client/v2/indexer"
 	"github.com/rs/zerolog/log"
+	"go.uber.org/atomic"
 	"go.uber.org/ratelimit"
 )
 
@@ -19,13 +21,18 @@ type ProcessorFunc func(*models.Block)
 
 // Fetcher handles block fetching from algod or indexer
 type Fetcher struct {
-	client    *indexer.Client
-	currRound uint64
-	ctx       context.Context
-	cancel    context.CancelFunc
-	queue     chan *models.Block
-	processor ProcessorFunc
-	rl        ratelimit.Limiter
+	client         *indexer.Client
+	currRound      uint64
+	processedRound atomic.Uint64
+	ctx            context.Context
+	cancel         context.CancelFunc
+	abortChan      chan struct{}
+	abortOnce      sync.Once
+	doneChan       chan struct{}
+	queue          chan *models.Block
+	processor      ProcessorFunc
+	rlMu           sync.RWMutex
+	rl             ratelimit.Limiter
 }
 
 // Config represents a configuration
@@ -59,15 +66,20 @@ func New(conf Config) (*Fetcher, error) {
 
 	ctx, cancel = context.WithCancel(context.Background())
 
-	return &Fetcher{
+	f := &Fetcher{
 		client:    client,
 		ctx:       ctx,
 		cancel:    cancel,
+		abortChan: make(chan struct{}),
+		doneChan:  make(chan struct{}),
 		currRound: *currRound,
 		queue:     make(chan *models.Block, blockQueueSize),
 		processor: conf.Processor,
 		rl:        ratelimit.New(conf.RPS),
-	}, nil
+	}
+	f.processedRound.Store(*currRound)
+
+	return f, nil
 }
 
 func (f *Fetcher) fetchLoop() {
@@ -80,12 +92,19 @@ func (f *Fetcher) fetchLoop() {
 		default:
 		}
 
-		f.rl.Take()
+		f.limiter().Take()
 		nextRound := f.currRound + 1
 		block, err := f.client.LookupBlock(nextRound).Do(f.ctx)
 		if err != nil {
+			if f.ctx.Err() != nil {
+				return
+			}
+
 			if _, ok := err.(common.NotFound); ok {
-				time.Sleep(time.Duration(1) * time.Second)
+				select {
+				case <-f.ctx.Done():
+				case <-time.After(time.Duration(1) * time.Second):
+				}
 				log.Info().Msg("fetcher: no new round")
 
 				continue
@@ -95,27 +114,35 @@ func (f *Fetcher) fetchLoop() {
 			continue
 		}
 
-		f.queue <- &block
+		select {
+		case <-f.ctx.Done():
+			return
+		case f.queue <- &block:
+		}
+
 		f.currRound = nextRound
 		log.Info().Msgf("fetcher: current round is %v", f.currRound)
 	}
 }
 
+// processLoop processes blocks until the queue is closed and empty, or the fetcher is aborted
 func (f *Fetcher) processLoop() {
+	defer close(f.doneChan)
+
 	for {
 		select {
-		case <-f.ctx.Done():
+		case <-f.abortChan:
 			return
-		default:
-		}
+		case block, open := <-f.queue:
+			if !open {
+				return
+			}
 
-		block, open := <-f.queue
-		if !open {
-			return
-		}
+			if f.processor != nil {
+				f.processor(block)
+			}
 
-		if f.processor != nil {
-			f.processor(block)
+			f.processedRound.Store(block.Round)
 		}
 	}
 }
@@ -126,7 +153,58 @@ func (f *Fetcher) Start() {
 	go f.processLoop()
 }
 
-// Stop stops the fetcher
+// SetRPS changes the maximum rate of fetching blocks
+func (f *Fetcher) SetRPS(rps int) {
+	f.rlMu.Lock()
+	defer f.rlMu.Unlock()
+
+	f.rl = ratelimit.New(rps)
+}
+
+func (f *Fetcher) limiter() ratelimit.Limiter {
+	f.rlMu.RLock()
+	defer f.rlMu.RUnlock()
+
+	return f.rl
+}
+
+// LatestRound returns the latest round of the block source
+func (f *Fetcher) LatestRound(ctx context.Context) (uint64, error) {
+	resp, err := f.client.HealthCheck().Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Round, nil
+}
+
+// ProcessedRound returns the last round given to the processor, or the round before the start round
+func (f *Fetcher) ProcessedRound() uint64 {
+	return f.processedRound.Load()
+}
+
+// Stop stops the fetcher, queued blocks are abandoned
 func (f *Fetcher) Stop() {
 	f.cancel()
+	f.abort()
+}
+
+// Drain stops fetching new blocks and processes queued blocks until the queue is empty or the context is done
+func (f *Fetcher) Drain(ctx context.Context) error {
+	f.cancel()
+
+	select {
+	case <-f.doneChan:
+		return nil
+	case <-ctx.Done():
+		f.abort()
+
+		return ctx.Err()
+	}
+}
+
+func (f *Fetcher) abort() {
+	f.abortOnce.Do(func() {
+		close(f.abortChan)
+	})
 }
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/synycboom/algorand-notification/auth"
+)
+
+const (
+	apiKeyHeader = "X-API-Key"
+
+	// principalKey is a key of the principal of a request in the echo context
+	principalKey = "principal"
+
+	// adminKey is a key of the echo context set for requests with the webhook API token
+	adminKey = "admin"
+)
+
+// authenticate authenticates a request by a token from the Authorization header, the X-API-Key header or the token query param.
+// It returns a nil principal without an error if a token is not given, so that the connection can authenticate later.
+func (h *Handler) authenticate(r *http.Request) (*auth.Principal, error) {
+	if h.conf.Authenticator == nil {
+		return nil, nil
+	}
+
+	token := requestToken(r)
+	if token == "" {
+		return nil, nil
+	}
+
+	return h.conf.Authenticator.Authenticate(token)
+}
+
+// requestToken returns a token from the Authorization header, the X-API-Key header or the token query param
+func requestToken(r *http.Request) string {
+	token := r.URL.Query().Get("token")
+	if v := r.Header.Get(apiKeyHeader); v != "" {
+		token = v
+	}
+
+	if v := r.Header.Get("Authorization"); strings.HasPrefix(v, "Bearer ") {
+		token = strings.TrimPrefix(v, "Bearer ")
+	}
+
+	return token
+}
+
+// Authenticate is a middleware rejecting requests without a valid token when authentication is enabled,
+// the principal of a request is kept in the context
+func (h *Handler) Authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		principal, err := h.authenticate(c.Request())
+		if err != nil || (h.conf.Authenticator != nil && principal == nil) {
+			return c.JSON(http.StatusUnauthorized, ErrorResponse{Message: "unauthorized"})
+		}
+
+		c.Set(principalKey, principal)
+
+		return next(c)
+	}
+}
+
+// AuthenticateWebhooks is a middleware allowing the webhook API to requests with the webhook API token,
+// or with a token of a principal that has a subject when authentication is enabled
+func (h *Handler) AuthenticateWebhooks(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token := requestToken(c.Request())
+		if h.conf.WebhookAPIToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(h.conf.WebhookAPIToken)) == 1 {
+			c.Set(adminKey, true)
+
+			return next(c)
+		}
+
+		if h.conf.Authenticator == nil || token == "" {
+			return c.JSON(http.StatusUnauthorized, ErrorResponse{Message: "unauthorized"})
+		}
+
+		principal, err := h.conf.Authenticator.Authenticate(token)
+		if err != nil || principal == nil {
+			return c.JSON(http.StatusUnauthorized, ErrorResponse{Message: "unauthorized"})
+		}
+
+		// webhooks are owned by the subject of their principal
+		if principal.Subject == "" {
+			return c.JSON(http.StatusForbidden, ErrorResponse{Message: "a subject is required to manage webhooks"})
+		}
+
+		c.Set(principalKey, principal)
+
+		return next(c)
+	}
+}
+
+// isAdmin returns true if a request has the webhook API token
+func isAdmin(c echo.Context) bool {
+	admin, _ := c.Get(adminKey).(bool)
+
+	return admin
+}
+
+// principalOf returns the principal set by Authenticate, it is nil if authentication is disabled
+func principalOf(c echo.Context) *auth.Principal {
+	p, _ := c.Get(principalKey).(*auth.Principal)
+
+	return p
+}
+
+// authenticateContext authenticates a gRPC call by a token from the authorization or x-api-key metadata
+func authenticateContext(ctx context.Context, a Authenticator) (*auth.Principal, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	var token string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(strings.ToLower(apiKeyHeader)); len(v) > 0 {
+		token = v[0]
+	}
+
+	if v := md.Get("authorization"); len(v) > 0 && strings.HasPrefix(v[0], "Bearer ") {
+		token = strings.TrimPrefix(v[0], "Bearer ")
+	}
+
+	return a.Authenticate(token)
+}
+
+// authorize checks if a principal may subscribe events at once
+func authorize(p *auth.Principal, types []string) error {
+	if p == nil {
+		return nil
+	}
+
+	for _, t := range types {
+		if !p.Allows(t) {
+			return fmt.Errorf("event %s is not allowed", t)
+		}
+	}
+
+	if p.MaxSubscriptions > 0 && len(types) > p.MaxSubscriptions {
+		return fmt.Errorf("subscriptions exceed the limit of %d", p.MaxSubscriptions)
+	}
+
+	return nil
+}
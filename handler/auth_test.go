@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/event"
+)
+
+// keyAuthenticator authenticates static tokens
+type keyAuthenticator map[string]*auth.Principal
+
+func (a keyAuthenticator) Authenticate(token string) (*auth.Principal, error) {
+	p, ok := a[token]
+	if !ok {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return p, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	tenant := &auth.Principal{Subject: "tenant-a"}
+	tests := []struct {
+		name          string
+		authenticator Authenticator
+		target        string
+		header        map[string]string
+		status        int
+		principal     *auth.Principal
+	}{
+		{name: "disabled", target: "/v1/events", status: http.StatusOK},
+		{name: "disabled ignores tokens", target: "/v1/events?token=unknown", status: http.StatusOK},
+		{name: "without a token", authenticator: keyAuthenticator{"key": tenant}, target: "/v1/events", status: http.StatusUnauthorized},
+		{name: "unknown token", authenticator: keyAuthenticator{"key": tenant}, target: "/v1/events?token=unknown", status: http.StatusUnauthorized},
+		{name: "query param", authenticator: keyAuthenticator{"key": tenant}, target: "/v1/events?token=key", status: http.StatusOK, principal: tenant},
+		{
+			name:          "API key header",
+			authenticator: keyAuthenticator{"key": tenant},
+			target:        "/v1/events",
+			header:        map[string]string{apiKeyHeader: "key"},
+			status:        http.StatusOK,
+			principal:     tenant,
+		},
+		{
+			name:          "bearer token takes precedence",
+			authenticator: keyAuthenticator{"key": tenant},
+			target:        "/v1/events?token=unknown",
+			header:        map[string]string{apiKeyHeader: "unknown", "Authorization": "Bearer key"},
+			status:        http.StatusOK,
+			principal:     tenant,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := New(Config{Authenticator: tc.authenticator})
+
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			var principal *auth.Principal
+			_ = h.Authenticate(func(c echo.Context) error {
+				principal = principalOf(c)
+
+				return c.NoContent(http.StatusOK)
+			})(echo.New().NewContext(req, rec))
+
+			if rec.Code != tc.status || principal != tc.principal {
+				t.Fatalf("responded %d with the principal %v, want %d and %v", rec.Code, principal, tc.status, tc.principal)
+			}
+		})
+	}
+}
+
+func TestEventsOfPrincipal(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		query     string
+		status    int
+		events    string
+	}{
+		{name: "all events allowed", principal: &auth.Principal{}, status: http.StatusOK, events: "b10,T1,T2,b11,T3"},
+		{
+			name:      "filtered by allowed events",
+			principal: &auth.Principal{Events: []string{event.NewPaymentTx, event.NewAssetTransferTx}},
+			status:    http.StatusOK,
+			events:    "T1,T2,T3",
+		},
+		{
+			name:      "allowed type",
+			principal: &auth.Principal{Events: []string{event.NewPaymentTx}},
+			query:     "?type=NEW_PAYMENT_TX",
+			status:    http.StatusOK,
+			events:    "T1,T3",
+		},
+		{
+			name:      "disallowed type",
+			principal: &auth.Principal{Events: []string{event.NewPaymentTx}},
+			query:     "?type=NEW_BLOCK",
+			status:    http.StatusForbidden,
+		},
+		{
+			name:      "invalid type is not forbidden",
+			principal: &auth.Principal{Events: []string{event.NewPaymentTx}},
+			query:     "?type=UNKNOWN",
+			status:    http.StatusBadRequest,
+		},
+	}
+
+	h := newQueryHandler(t, queryEvents())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := get(h.Events, "/v1/events"+tc.query, tc.principal)
+			if rec.Code != tc.status {
+				t.Fatalf("responded %d %s, want %d", rec.Code, rec.Body.String(), tc.status)
+			}
+
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			if events, _ := eventsOf(t, rec); events != tc.events {
+				t.Fatalf("got events %q, want %q", events, tc.events)
+			}
+		})
+	}
+}
+
+func TestTxOfPrincipal(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		status    int
+	}{
+		{name: "allowed", principal: &auth.Principal{Events: []string{event.NewPaymentTx}}, status: http.StatusOK},
+		// disallowed transactions are not revealed to exist
+		{name: "disallowed", principal: &auth.Principal{Events: []string{event.NewBlock}}, status: http.StatusNotFound},
+	}
+
+	h := newQueryHandler(t, queryEvents())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if rec := get(h.Tx, "/v1/tx/T1", tc.principal, "T1"); rec.Code != tc.status {
+				t.Fatalf("responded %d, want %d", rec.Code, tc.status)
+			}
+		})
+	}
+}
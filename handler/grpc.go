@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/synycboom/algorand-notification/client"
+	notificationv1 "github.com/synycboom/algorand-notification/proto/notification/v1"
+)
+
+// GRPCHandler is a gRPC notification service handler
+type GRPCHandler struct {
+	notificationv1.UnimplementedNotificationServiceServer
+
+	conf Config
+}
+
+// NewGRPC creates a new gRPC handler
+func NewGRPC(c Config) *GRPCHandler {
+	return &GRPCHandler{
+		conf: c,
+	}
+}
+
+// Subscribe streams events matched with the request until the peer cancels
+func (h *GRPCHandler) Subscribe(req *notificationv1.SubscribeRequest, stream notificationv1.NotificationService_SubscribeServer) error {
+	if h.conf.Hub.IsDraining() {
+		return status.Error(codes.Unavailable, "server is shutting down")
+	}
+
+	if len(req.Events) == 0 {
+		return status.Error(codes.InvalidArgument, "events are required")
+	}
+
+	for _, t := range req.Events {
+		if !isValidEventType(t) {
+			return status.Error(codes.InvalidArgument, "events are invalid")
+		}
+	}
+
+	principal, err := authenticateContext(stream.Context(), h.conf.Authenticator)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := authorize(principal, req.Events); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if qErr := limitSubscriptions(h.conf.Quota, principal, req.Events); qErr != nil {
+		return status.Error(codes.ResourceExhausted, qErr.Message)
+	}
+
+	var remoteIP string
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		remoteIP, _, _ = net.SplitHostPort(p.Addr.String())
+	}
+
+	release, qErr := acquire(h.conf.Quota, principal, remoteIP)
+	if qErr != nil {
+		return status.Error(codes.ResourceExhausted, qErr.Message)
+	}
+	defer release()
+
+	cl := h.conf.ClientFactory.NewGRPC(stream, client.StreamConfig{
+		Types:   req.Events,
+		Address: req.Address,
+		AssetID: req.AssetId,
+		AppID:   req.AppId,
+	})
+	h.conf.Hub.Register(cl)
+	cl.Subscribe()
+
+	return cl.Serve(stream.Context())
+}
@@ -4,8 +4,13 @@ import (
 	"net/http"
 
 	"github.com/gorilla/websocket"
+	"github.com/synycboom/algorand-notification/auth"
 	"github.com/synycboom/algorand-notification/client"
+	"github.com/synycboom/algorand-notification/event"
 	"github.com/synycboom/algorand-notification/hub"
+	"github.com/synycboom/algorand-notification/quota"
+	"github.com/synycboom/algorand-notification/store"
+	"github.com/synycboom/algorand-notification/webhook"
 )
 
 // Upgrader represents a contract for http upgrade
@@ -18,12 +23,63 @@ type Upgrader interface {
 type Hub interface {
 	// Register registers a client to the hub
 	Register(client hub.Client)
+
+	// IsDraining returns true if the hub does not accept new clients
+	IsDraining() bool
 }
 
 // ClientFactory represents a contract for client factory
 type ClientFactory interface {
 	// New creates a new websocket client
-	New(conn client.GorillaConnection) *client.Client
+	New(conn client.GorillaConnection, s client.Session) *client.Client
+
+	// NewStream creates a new server-sent events client
+	NewStream(w client.StreamWriter, sc client.StreamConfig) *client.StreamClient
+
+	// NewGRPC creates a new gRPC streaming client
+	NewGRPC(stream client.EventStream, sc client.StreamConfig) *client.GRPCClient
+}
+
+// Store represents a contract for recently received events
+type Store interface {
+	// Query returns events matched with the filter
+	Query(f store.Filter) (*store.Page, error)
+
+	// LatestRound returns the latest round seen by the store
+	LatestRound() (uint64, bool)
+
+	// Tx returns a transaction event by its id
+	Tx(id string) (*event.Event, bool)
+}
+
+// Webhooks represents a contract for webhook subscriptions management
+type Webhooks interface {
+	// Add adds a subscription
+	Add(sub webhook.Subscription) error
+
+	// Remove removes a subscription
+	Remove(id string) error
+
+	// List returns all subscriptions
+	List() []webhook.Subscription
+
+	// DeadLetters returns events that could not be delivered
+	DeadLetters() []webhook.DeadLetter
+}
+
+// Authenticator represents a contract for authenticating API keys and JWTs
+type Authenticator interface {
+	// Authenticate returns a principal of a token
+	Authenticate(token string) (*auth.Principal, error)
+}
+
+// Quota represents a contract for connection quotas
+type Quota interface {
+	// Acquire reserves a connection slot of a tenant
+	Acquire(tenant string) (release func(), err error)
+
+	// Config returns the current quota configuration
+	Config() quota.Config
 }
 
 // Config is a configuration
@@ -31,6 +87,26 @@ type Config struct {
 	Hub           Hub
 	Upgrader      Upgrader
 	ClientFactory ClientFactory
+	Store         Store
+	Webhooks      Webhooks
+
+	// Authenticator authenticates connections, authentication is disabled if it is nil
+	Authenticator Authenticator
+
+	// Quota limits connections of each tenant, nothing is limited if it is nil
+	Quota Quota
+
+	// Compression must match EnableCompression of the upgrader
+	Compression bool
+
+	// Version is a protocol version of events sent to clients that do not choose one
+	Version int
+
+	// WebhookAPIToken is an admin token of the webhook API, only principals may use the API if it is empty
+	WebhookAPIToken string
+
+	// Replay enables the Last-Event-ID replay of server-sent events, the store must then receive every event
+	Replay bool
 }
 
 // Handler is a http handler
@@ -44,4 +120,3 @@ func New(c Config) *Handler {
 		conf: c,
 	}
 }
-
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/store"
+)
+
+// ErrorResponse represents a http error response
+type ErrorResponse struct {
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message"`
+}
+
+// EventsResponse represents a page of recent events
+type EventsResponse struct {
+	Events     []json.RawMessage `json:"events"`
+	NextCursor string            `json:"nextCursor,omitempty"`
+}
+
+// LatestRoundResponse represents the latest received round
+type LatestRoundResponse struct {
+	Round uint64 `json:"round"`
+}
+
+// Events returns recently received events matched with query params
+func (h *Handler) Events(c echo.Context) error {
+	filter := store.Filter{
+		Address: c.QueryParam("address"),
+		Cursor:  c.QueryParam("cursor"),
+	}
+
+	principal := principalOf(c)
+	if t := c.QueryParam("type"); t != "" {
+		if !isValidEventType(t) {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "type is invalid"})
+		}
+
+		if !principal.Allows(t) {
+			return c.JSON(http.StatusForbidden, ErrorResponse{Message: fmt.Sprintf("event %s is not allowed", t)})
+		}
+
+		filter.Types = []string{t}
+	} else if principal != nil {
+		// an empty list allows every event
+		filter.Types = principal.Events
+	}
+
+	if v := c.QueryParam("fromRound"); v != "" {
+		round, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "fromRound is invalid"})
+		}
+
+		filter.FromRound = round
+	}
+
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "limit is invalid"})
+		}
+
+		filter.Limit = limit
+	}
+
+	page, err := h.conf.Store.Query(filter)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	}
+
+	res := EventsResponse{
+		Events:     make([]json.RawMessage, 0, len(page.Events)),
+		NextCursor: page.NextCursor,
+	}
+	for _, e := range page.Events {
+		res.Events = append(res.Events, e.Payload)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// LatestRound returns the latest received round
+func (h *Handler) LatestRound(c echo.Context) error {
+	round, ok := h.conf.Store.LatestRound()
+	if !ok {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Message: "no round has been received"})
+	}
+
+	return c.JSON(http.StatusOK, LatestRoundResponse{Round: round})
+}
+
+// Tx returns a recently received transaction event by its id
+func (h *Handler) Tx(c echo.Context) error {
+	e, ok := h.conf.Store.Tx(c.Param("id"))
+	if !ok || !principalOf(c).Allows(e.Type) {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Message: "transaction not found"})
+	}
+
+	return c.JSONBlob(http.StatusOK, e.Payload)
+}
+
+func isValidEventType(t string) bool {
+	for _, e := range event.AllEvents {
+		if e == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/store"
+)
+
+// newQueryHandler creates a handler querying a store of the events, payloads of the events are JSON strings
+func newQueryHandler(t *testing.T, events []*event.Event) *Handler {
+	t.Helper()
+
+	s, err := store.NewMemory(store.MemoryConfig{Size: len(events) + 1})
+	if err != nil {
+		t.Fatalf("failed to create a store: %v", err)
+	}
+	s.Add(events)
+
+	return New(Config{Store: s})
+}
+
+// queryEvents returns events of two rounds
+func queryEvents() []*event.Event {
+	return []*event.Event{
+		{Type: event.NewBlock, Round: 10, Payload: []byte(`"b10"`)},
+		{Type: event.NewPaymentTx, Round: 10, TxID: "T1", Addresses: []string{"A", "B"}, Payload: []byte(`"T1"`)},
+		{Type: event.NewAssetTransferTx, Round: 10, TxID: "T2", Addresses: []string{"C"}, AssetID: 5, Payload: []byte(`"T2"`)},
+		{Type: event.NewBlock, Round: 11, Payload: []byte(`"b11"`)},
+		{Type: event.NewPaymentTx, Round: 11, TxID: "T3", Addresses: []string{"C"}, Payload: []byte(`"T3"`)},
+	}
+}
+
+// get serves a GET request by a handler function with the principal set by Authenticate
+func get(h echo.HandlerFunc, target string, principal *auth.Principal, params ...string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, target, nil), rec)
+	if principal != nil {
+		c.Set(principalKey, principal)
+	}
+
+	if len(params) > 0 {
+		c.SetParamNames("id")
+		c.SetParamValues(params...)
+	}
+
+	_ = h(c)
+
+	return rec
+}
+
+// eventsOf returns payloads of the events in the response, and the next cursor
+func eventsOf(t *testing.T, rec *httptest.ResponseRecorder) (string, string) {
+	t.Helper()
+
+	var res EventsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("failed to decode the response %s: %v", rec.Body.String(), err)
+	}
+
+	payloads := make([]string, 0, len(res.Events))
+	for _, e := range res.Events {
+		payloads = append(payloads, strings.Trim(string(e), `"`))
+	}
+
+	return strings.Join(payloads, ","), res.NextCursor
+}
+
+func TestEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		status int
+		events string
+		cursor string
+	}{
+		{name: "all", query: "", status: http.StatusOK, events: "b10,T1,T2,b11,T3", cursor: "5"},
+		{name: "type", query: "?type=NEW_BLOCK", status: http.StatusOK, events: "b10,b11", cursor: "4"},
+		{name: "from round", query: "?fromRound=11", status: http.StatusOK, events: "b11,T3", cursor: "5"},
+		{name: "limit", query: "?limit=2", status: http.StatusOK, events: "b10,T1", cursor: "2"},
+		{name: "cursor", query: "?cursor=2&limit=2", status: http.StatusOK, events: "T2,b11", cursor: "4"},
+		{name: "last page", query: "?cursor=4", status: http.StatusOK, events: "T3", cursor: "5"},
+		{name: "cursor is kept on an empty page", query: "?cursor=5", status: http.StatusOK, events: "", cursor: "5"},
+		{name: "invalid type", query: "?type=UNKNOWN", status: http.StatusBadRequest},
+		{name: "invalid from round", query: "?fromRound=-1", status: http.StatusBadRequest},
+		{name: "zero limit", query: "?limit=0", status: http.StatusBadRequest},
+		{name: "negative limit", query: "?limit=-1", status: http.StatusBadRequest},
+		{name: "invalid limit", query: "?limit=a", status: http.StatusBadRequest},
+		{name: "invalid cursor", query: "?cursor=a", status: http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newQueryHandler(t, queryEvents())
+			rec := get(h.Events, "/v1/events"+tc.query, nil)
+			if rec.Code != tc.status {
+				t.Fatalf("responded %d %s, want %d", rec.Code, rec.Body.String(), tc.status)
+			}
+
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			events, cursor := eventsOf(t, rec)
+			if events != tc.events || cursor != tc.cursor {
+				t.Fatalf("got events %q and the cursor %q, want %q and %q", events, cursor, tc.events, tc.cursor)
+			}
+		})
+	}
+}
+
+func TestEventsLimitIsBounded(t *testing.T) {
+	events := make([]*event.Event, store.MaxLimit+1)
+	for i := range events {
+		events[i] = &event.Event{Type: event.NewBlock, Round: uint64(i), Payload: []byte(fmt.Sprintf(`"%d"`, i))}
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "default", query: "", want: store.DefaultLimit},
+		{name: "maximum", query: fmt.Sprintf("?limit=%d", store.MaxLimit), want: store.MaxLimit},
+		{name: "over the maximum", query: fmt.Sprintf("?limit=%d", store.MaxLimit+1), want: store.MaxLimit},
+	}
+
+	h := newQueryHandler(t, events)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var res EventsResponse
+			rec := get(h.Events, "/v1/events"+tc.query, nil)
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("failed to decode the response: %v", err)
+			}
+
+			if len(res.Events) != tc.want {
+				t.Fatalf("got %d events, want %d", len(res.Events), tc.want)
+			}
+		})
+	}
+}
+
+func TestLatestRound(t *testing.T) {
+	rec := get(newQueryHandler(t, nil).LatestRound, "/v1/rounds/latest", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("empty store responded %d", rec.Code)
+	}
+
+	rec = get(newQueryHandler(t, queryEvents()).LatestRound, "/v1/rounds/latest", nil)
+	if rec.Code != http.StatusOK || strings.TrimSpace(rec.Body.String()) != `{"round":11}` {
+		t.Fatalf("responded %d %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestTx(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		status int
+	}{
+		{name: "found", id: "T1", status: http.StatusOK},
+		{name: "not found", id: "T9", status: http.StatusNotFound},
+	}
+
+	h := newQueryHandler(t, queryEvents())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := get(h.Tx, "/v1/tx/"+tc.id, nil, tc.id)
+			if rec.Code != tc.status {
+				t.Fatalf("responded %d, want %d", rec.Code, tc.status)
+			}
+
+			if tc.status == http.StatusOK && rec.Body.String() != `"`+tc.id+`"` {
+				t.Fatalf("responded %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestEventsByAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		query     string
+		events    string
+	}{
+		{name: "address", query: "?address=C", events: "T2,T3"},
+		{name: "address and type", query: "?address=C&type=NEW_PAYMENT_TX", events: "T3"},
+		{name: "address of a principal", principal: &auth.Principal{Events: []string{event.NewPaymentTx}}, query: "?address=C", events: "T3"},
+		{name: "unknown address", query: "?address=D", events: ""},
+		// asset and application filters are only used for routing between replicas
+		{name: "asset and application ids are ignored", query: "?address=C&assetId=1&appId=1", events: "T2,T3"},
+	}
+
+	h := newQueryHandler(t, queryEvents())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := get(h.Events, "/v1/events"+tc.query, tc.principal)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("responded %d %s", rec.Code, rec.Body.String())
+			}
+
+			if events, _ := eventsOf(t, rec); events != tc.events {
+				t.Fatalf("got events %q, want %q", events, tc.events)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/metrics"
+	"github.com/synycboom/algorand-notification/quota"
+)
+
+// acquire reserves a connection slot for an API key subject, or for a remote IP if the connection is anonymous
+func acquire(q Quota, principal *auth.Principal, remoteIP string) (func(), *quota.Error) {
+	if q == nil {
+		return func() {}, nil
+	}
+
+	tenant := "ip:" + remoteIP
+	if principal != nil && principal.Subject != "" {
+		tenant = "key:" + principal.Subject
+	}
+
+	release, err := q.Acquire(tenant)
+	if err != nil {
+		qErr, ok := err.(*quota.Error)
+		if !ok {
+			qErr = &quota.Error{Reason: quota.ReasonConnectionLimit, Message: err.Error()}
+		}
+
+		metrics.ObserveQuotaRejection(tenantLabel(principal), qErr.Reason)
+
+		return nil, qErr
+	}
+
+	return release, nil
+}
+
+// limitSubscriptions checks the subscriptions limit of a connection that subscribes events at once
+func limitSubscriptions(q Quota, principal *auth.Principal, types []string) *quota.Error {
+	if q == nil {
+		return nil
+	}
+
+	if max := q.Config().MaxSubscriptionsPerConnection; max > 0 && len(types) > max {
+		metrics.ObserveQuotaRejection(tenantLabel(principal), quota.ReasonSubscriptionLimit)
+
+		return &quota.Error{
+			Reason:  quota.ReasonSubscriptionLimit,
+			Message: fmt.Sprintf("subscriptions exceed the limit of %d", max),
+		}
+	}
+
+	return nil
+}
+
+// tenantLabel returns a metric label of a tenant, anonymous tenants share a label to keep the cardinality low
+func tenantLabel(principal *auth.Principal) string {
+	if principal != nil && principal.Subject != "" {
+		return principal.Subject
+	}
+
+	return "anonymous"
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/synycboom/algorand-notification/auth"
+	"github.com/synycboom/algorand-notification/quota"
+)
+
+// tenantRecorder records tenants of acquired connections
+type tenantRecorder struct {
+	*quota.Limiter
+	tenants []string
+}
+
+func (r *tenantRecorder) Acquire(tenant string) (func(), error) {
+	r.tenants = append(r.tenants, tenant)
+
+	return r.Limiter.Acquire(tenant)
+}
+
+func TestAcquireTenant(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		want      string
+	}{
+		{name: "anonymous", principal: nil, want: "ip:10.0.0.1"},
+		{name: "principal without a subject", principal: &auth.Principal{}, want: "ip:10.0.0.1"},
+		{name: "API key", principal: &auth.Principal{Subject: "tenant-a"}, want: "key:tenant-a"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &tenantRecorder{Limiter: quota.New(quota.Config{MaxConnectionsPerTenant: 1})}
+			if _, qErr := acquire(r, tc.principal, "10.0.0.1"); qErr != nil {
+				t.Fatalf("failed to acquire: %v", qErr)
+			}
+
+			if _, qErr := acquire(r, tc.principal, "10.0.0.1"); qErr == nil || qErr.Reason != quota.ReasonConnectionLimit {
+				t.Fatalf("a connection over the limit returned %v", qErr)
+			}
+
+			if r.tenants[0] != tc.want {
+				t.Fatalf("tenant is %q, want %q", r.tenants[0], tc.want)
+			}
+		})
+	}
+
+	if release, qErr := acquire(nil, nil, "10.0.0.1"); qErr != nil || release == nil {
+		t.Fatalf("acquiring without quotas returned %v", qErr)
+	}
+}
+
+func TestLimitSubscriptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		max   int
+		types []string
+		want  bool
+	}{
+		{name: "unlimited", max: 0, types: []string{"NEW_BLOCK", "NEW_PAYMENT_TX"}, want: false},
+		{name: "under the limit", max: 2, types: []string{"NEW_BLOCK"}, want: false},
+		{name: "at the limit", max: 2, types: []string{"NEW_BLOCK", "NEW_PAYMENT_TX"}, want: false},
+		{name: "over the limit", max: 1, types: []string{"NEW_BLOCK", "NEW_PAYMENT_TX"}, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			q := quota.New(quota.Config{MaxSubscriptionsPerConnection: tc.max})
+			qErr := limitSubscriptions(q, nil, tc.types)
+			if rejected := qErr != nil; rejected != tc.want {
+				t.Fatalf("rejected %v, want %v", rejected, tc.want)
+			}
+
+			if qErr != nil && qErr.Reason != quota.ReasonSubscriptionLimit {
+				t.Fatalf("rejected with the reason %s", qErr.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/client"
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/store"
+)
+
+// Stream handles server-sent events subscriptions
+func (h *Handler) Stream(c echo.Context) error {
+	if h.conf.Hub.IsDraining() {
+		return c.JSON(http.StatusServiceUnavailable, ErrorResponse{Message: "server is shutting down"})
+	}
+
+	types := strings.Split(c.QueryParam("events"), ",")
+	for _, t := range types {
+		if !isValidEventType(t) {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "events are invalid"})
+		}
+	}
+
+	principal, err := h.authenticate(c.Request())
+	if err != nil || (h.conf.Authenticator != nil && principal == nil) {
+		return c.JSON(http.StatusUnauthorized, ErrorResponse{Message: "unauthorized"})
+	}
+
+	if err := authorize(principal, types); err != nil {
+		return c.JSON(http.StatusForbidden, ErrorResponse{Message: err.Error()})
+	}
+
+	if qErr := limitSubscriptions(h.conf.Quota, principal, types); qErr != nil {
+		return c.JSON(http.StatusTooManyRequests, ErrorResponse{Reason: qErr.Reason, Message: qErr.Message})
+	}
+
+	// without replay, reconnecting clients continue with live events
+	var cursor string
+	if h.conf.Replay {
+		cursor = c.Request().Header.Get("Last-Event-ID")
+	}
+
+	filter := store.Filter{
+		Types:   types,
+		Address: c.QueryParam("address"),
+		Cursor:  cursor,
+		Limit:   store.MaxLimit,
+	}
+
+	sc := client.StreamConfig{
+		Types:   filter.Types,
+		Address: filter.Address,
+	}
+
+	if v := c.QueryParam("assetId"); v != "" {
+		if sc.AssetID, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "assetId is invalid"})
+		}
+	}
+
+	if v := c.QueryParam("appId"); v != "" {
+		if sc.AppID, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "appId is invalid"})
+		}
+	}
+
+	version, ok := h.negotiateVersion(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "version is invalid"})
+	}
+
+	if cursor != "" {
+		if _, err := strconv.ParseUint(cursor, 10, 64); err != nil {
+			return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "Last-Event-ID is invalid"})
+		}
+	}
+
+	release, qErr := acquire(h.conf.Quota, principal, c.RealIP())
+	if qErr != nil {
+		return c.JSON(http.StatusTooManyRequests, ErrorResponse{Reason: qErr.Reason, Message: qErr.Message})
+	}
+	defer release()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	sc.Version = version
+	cl := h.conf.ClientFactory.NewStream(res, sc)
+
+	next := func() ([]*event.Event, error) {
+		page, err := h.conf.Store.Query(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Cursor = page.NextCursor
+
+		return page.Events, nil
+	}
+
+	// missed events are replayed page by page before registering, so a long replay does not hold up the hub
+	if cursor != "" {
+		if err := cl.Replay(next); err != nil {
+			cl.Close()
+
+			return nil
+		}
+	}
+
+	h.conf.Hub.Register(cl)
+	cl.Subscribe()
+
+	// events stored until the client was subscribed are replayed while live events are buffered,
+	// events received in both ways are written once
+	if cursor != "" {
+		if err := cl.Replay(next); err != nil || cl.IsClosed() {
+			cl.Close()
+
+			return nil
+		}
+	}
+
+	log.Debug().Msg("handler: successfully opened an event stream")
+
+	cl.Serve(c.Request().Context())
+
+	return nil
+}
@@ -2,23 +2,116 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/client"
+	"github.com/synycboom/algorand-notification/event"
 )
 
 // Upgrade handles websocket upgrading
 func (h *Handler) Upgrade(c echo.Context) error {
-	conn, err := h.conf.Upgrader.Upgrade(c.Response().Writer, c.Request(), nil)
+	if h.conf.Hub.IsDraining() {
+		return c.String(http.StatusServiceUnavailable, "server is shutting down")
+	}
+
+	principal, err := h.authenticate(c.Request())
+	if err != nil {
+		return c.String(http.StatusUnauthorized, "unauthorized")
+	}
+
+	format, subprotocol, ok := negotiateFormat(c)
+	if !ok {
+		return c.String(http.StatusBadRequest, "format is invalid")
+	}
+
+	version, ok := h.negotiateVersion(c)
+	if !ok {
+		return c.String(http.StatusBadRequest, "version is invalid")
+	}
+
+	// JSON-RPC notifications embed events as they are, so they must be JSON
+	jsonrpc := c.QueryParam("jsonrpc")
+	if jsonrpc != "" && (jsonrpc != client.JSONRPCVersion || format != event.FormatJSON) {
+		return c.String(http.StatusBadRequest, "jsonrpc is invalid")
+	}
+
+	release, qErr := acquire(h.conf.Quota, principal, c.RealIP())
+	if qErr != nil {
+		return c.JSON(http.StatusTooManyRequests, ErrorResponse{Reason: qErr.Reason, Message: qErr.Message})
+	}
+
+	var header http.Header
+	if subprotocol != "" {
+		header = http.Header{"Sec-WebSocket-Protocol": []string{subprotocol}}
+	}
+
+	conn, err := h.conf.Upgrader.Upgrade(c.Response().Writer, c.Request(), header)
 	if err != nil {
+		release()
 		log.Error().Err(err).Msg("handler: failed to upgrade http to websocket")
 
 		return c.String(http.StatusInternalServerError, "unexpected error")
 	}
 
-	h.conf.Hub.Register(h.conf.ClientFactory.New(conn))
+	// the upgrader accepts permessage-deflate whenever the peer offers it and compression is enabled
+	compression := h.conf.Compression && offersCompression(c.Request())
+	h.conf.Hub.Register(h.conf.ClientFactory.New(conn, client.Session{
+		Principal:   principal,
+		Release:     release,
+		Format:      format,
+		Compression: compression,
+		Version:     version,
+		JSONRPC:     jsonrpc != "",
+	}))
 
 	log.Debug().Msg("handler: sucessfully upgrade connection")
 
 	return nil
 }
+
+// negotiateFormat returns a wire format chosen by the format query param or the first supported subprotocol,
+// and the subprotocol to accept. It returns false if the query param is not a supported format.
+func negotiateFormat(c echo.Context) (string, string, bool) {
+	if format := c.QueryParam("format"); format != "" {
+		return format, "", event.IsFormat(format)
+	}
+
+	for _, subprotocol := range websocket.Subprotocols(c.Request()) {
+		if event.IsFormat(subprotocol) {
+			return subprotocol, subprotocol, true
+		}
+	}
+
+	return event.FormatJSON, "", true
+}
+
+// negotiateVersion returns a protocol version chosen by the version query param or the default one,
+// it returns false if the query param is not a supported version
+func (h *Handler) negotiateVersion(c echo.Context) (int, bool) {
+	v := c.QueryParam("version")
+	if v == "" {
+		return h.conf.Version, true
+	}
+
+	version, err := strconv.Atoi(v)
+
+	return version, err == nil && event.IsVersion(version)
+}
+
+// offersCompression returns true if the peer offers the permessage-deflate extension
+func offersCompression(r *http.Request) bool {
+	for _, header := range r.Header.Values("Sec-WebSocket-Extensions") {
+		for _, ext := range strings.Split(header, ",") {
+			if strings.TrimSpace(strings.Split(ext, ";")[0]) == "permessage-deflate" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		protocols   string
+		format      string
+		subprotocol string
+		ok          bool
+	}{
+		{name: "default", format: "json", ok: true},
+		{name: "query param", query: "?format=msgpack", format: "msgpack", ok: true},
+		{name: "unsupported query param", query: "?format=xml", ok: false},
+		{name: "subprotocol", protocols: "cbor", format: "cbor", subprotocol: "cbor", ok: true},
+		{name: "first supported subprotocol", protocols: "graphql-ws, msgpack, cbor", format: "msgpack", subprotocol: "msgpack", ok: true},
+		{name: "unsupported subprotocols", protocols: "graphql-ws", format: "json", ok: true},
+		{name: "query param takes precedence", query: "?format=cbor", protocols: "msgpack", format: "cbor", ok: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/"+tc.query, nil)
+			if tc.protocols != "" {
+				req.Header.Set("Sec-WebSocket-Protocol", tc.protocols)
+			}
+
+			format, subprotocol, ok := negotiateFormat(echo.New().NewContext(req, httptest.NewRecorder()))
+			if ok != tc.ok {
+				t.Fatalf("ok is %v, want %v", ok, tc.ok)
+			}
+
+			if ok && (format != tc.format || subprotocol != tc.subprotocol) {
+				t.Fatalf("negotiated %q/%q, want %q/%q", format, subprotocol, tc.format, tc.subprotocol)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/synycboom/algorand-notification/webhook"
+)
+
+// ListWebhooks returns webhook subscriptions of the requester without their secrets
+func (h *Handler) ListWebhooks(c echo.Context) error {
+	subs := h.ownedWebhooks(c)
+	for i := range subs {
+		subs[i].Secret = ""
+	}
+
+	return c.JSON(http.StatusOK, subs)
+}
+
+// CreateWebhook adds a webhook subscription, a principal may only subscribe the events it is allowed to
+func (h *Handler) CreateWebhook(c echo.Context) error {
+	var sub webhook.Subscription
+	if err := c.Bind(&sub); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: "payload is invalid"})
+	}
+
+	if !isAdmin(c) {
+		principal := principalOf(c)
+		if err := authorize(principal, sub.Events); err != nil {
+			return c.JSON(http.StatusForbidden, ErrorResponse{Message: err.Error()})
+		}
+
+		sub.Owner = principal.Subject
+	}
+
+	if err := h.conf.Webhooks.Add(sub); err != nil {
+		if err == webhook.ErrSubscriptionExists {
+			return c.JSON(http.StatusConflict, ErrorResponse{Message: err.Error()})
+		}
+
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+	}
+
+	sub.Secret = ""
+
+	return c.JSON(http.StatusCreated, sub)
+}
+
+// DeleteWebhook removes a webhook subscription of the requester
+func (h *Handler) DeleteWebhook(c echo.Context) error {
+	id := c.Param("id")
+	if !containsWebhook(h.ownedWebhooks(c), id) {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Message: webhook.ErrSubscriptionNotFound.Error()})
+	}
+
+	if err := h.conf.Webhooks.Remove(id); err != nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Message: err.Error()})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// WebhookDeadLetters returns events that could not be delivered to webhook subscriptions of the requester
+func (h *Handler) WebhookDeadLetters(c echo.Context) error {
+	deadLetters := h.conf.Webhooks.DeadLetters()
+	if isAdmin(c) {
+		return c.JSON(http.StatusOK, deadLetters)
+	}
+
+	subs := h.ownedWebhooks(c)
+	owned := make([]webhook.DeadLetter, 0, len(deadLetters))
+	for _, d := range deadLetters {
+		if containsWebhook(subs, d.SubscriptionID) {
+			owned = append(owned, d)
+		}
+	}
+
+	return c.JSON(http.StatusOK, owned)
+}
+
+// ownedWebhooks returns every subscription for the admin, or the subscriptions created by the principal
+func (h *Handler) ownedWebhooks(c echo.Context) []webhook.Subscription {
+	subs := h.conf.Webhooks.List()
+	if isAdmin(c) {
+		return subs
+	}
+
+	subject := principalOf(c).Subject
+	owned := subs[:0]
+	for _, sub := range subs {
+		if sub.Owner == subject {
+			owned = append(owned, sub)
+		}
+	}
+
+	return owned
+}
+
+func containsWebhook(subs []webhook.Subscription, id string) bool {
+	for _, sub := range subs {
+		if sub.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+)
+
+// CheckFunc returns an error if a dependency is not ready
+type CheckFunc func(ctx context.Context) error
+
+// Check represents a named readiness check
+type Check struct {
+	Name  string
+	Check CheckFunc
+}
+
+// Response represents a health response
+type Response struct {
+	Status             string            `json:"status"`
+	LastProcessedRound uint64            `json:"lastProcessedRound"`
+	Checks             map[string]string `json:"checks,omitempty"`
+}
+
+// Config represents a health handler configuration
+type Config struct {
+	// Checks must all pass for the service to be ready
+	Checks []Check
+
+	// Round returns the last processed round
+	Round func() uint64
+
+	// Timeout is a deadline for running all checks
+	Timeout time.Duration
+}
+
+// Handler serves liveness and readiness probes
+type Handler struct {
+	conf Config
+}
+
+// New creates a new health handler
+func New(c Config) *Handler {
+	return &Handler{
+		conf: c,
+	}
+}
+
+// Register adds /healthz and /readyz to an echo server
+func (h *Handler) Register(e *echo.Echo) {
+	e.GET("/healthz", h.Healthz)
+	e.GET("/readyz", h.Readyz)
+}
+
+// Healthz reports that the process is alive
+func (h *Handler) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, Response{
+		Status:             statusOK,
+		LastProcessedRound: h.conf.Round(),
+	})
+}
+
+// Readyz reports whether every check passes, failed checks are reported with their errors
+func (h *Handler) Readyz(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.conf.Timeout)
+	defer cancel()
+
+	res := Response{
+		Status:             statusOK,
+		LastProcessedRound: h.conf.Round(),
+		Checks:             make(map[string]string),
+	}
+	for _, check := range h.conf.Checks {
+		if err := check.Check(ctx); err != nil {
+			res.Status = statusUnavailable
+			res.Checks[check.Name] = err.Error()
+
+			continue
+		}
+
+		res.Checks[check.Name] = statusOK
+	}
+
+	if res.Status != statusOK {
+		return c.JSON(http.StatusServiceUnavailable, res)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -1,7 +1,9 @@
 package hub
 
 import (
+	"context"
 	"sync"
+	"time"
 
 	"github.com/panjf2000/ants/v2"
 	"github.com/prometheus/client_golang/prometheus"
@@ -9,6 +11,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/interest"
 	"github.com/synycboom/algorand-notification/metrics"
 )
 
@@ -30,6 +33,33 @@ type Client interface {
 	Send(msg []byte)
 }
 
+// EventReceiver represents a client that needs event metadata in addition to the payload.
+// The hub calls SendEvent instead of Send for clients implementing it.
+type EventReceiver interface {
+	// SendEvent sends an event to the client
+	SendEvent(e *event.Event)
+}
+
+// RoundReceiver represents a client that receives the matched events of a round at once, e.g. to send them in one frame.
+// The hub calls SendRound instead of SendEvent for clients implementing it.
+type RoundReceiver interface {
+	// SendRound sends matched events of a round in order
+	SendRound(events []*event.Event)
+}
+
+// Shutdowner represents a client that can be closed gracefully with a reconnect hint
+type Shutdowner interface {
+	// Shutdown flushes pending events and closes the client, asking the peer to reconnect after a delay
+	Shutdown(reconnectAfter time.Duration)
+}
+
+// Filterer represents a client that only receives events matched by a filter.
+// The filter is only used to advertise the interest of the hub, clients still filter events themselves.
+type Filterer interface {
+	// Filter returns the filter of the client
+	Filter() interest.Filter
+}
+
 // SubscribeEvent is a subscription detail
 type SubscribeEvent struct {
 	ClientID uint64
@@ -42,18 +72,31 @@ type UnsubscribeEvent struct {
 	Types    []string
 }
 
+// message is a list of events of a round queued for dispatching, a broadcast message is sent to every client regardless of subscriptions
+type message struct {
+	events    []*event.Event
+	broadcast bool
+}
+
 // Hub maintains a set of active clients
 type Hub struct {
 	closeChan       chan struct{}
+	closeOnce       sync.Once
 	count           atomic.Uint64
+	draining        atomic.Bool
+	running         atomic.Bool
+	drainChan       chan time.Duration
 	clients         map[uint64]Client
 	pool            *ants.Pool
 	subscriptions   map[string]map[uint64]struct{}
+	filters         map[string]map[interest.Filter]int
 	subscribeChan   chan SubscribeEvent
 	unsubscribeChan chan UnsubscribeEvent
 	registerChan    chan Client
 	unregisterChan  chan Client
-	eventChan       chan *event.Event
+	eventChan       chan message
+	interest        atomic.Value
+	interestHandler func()
 }
 
 // New creates a new hub
@@ -65,10 +108,12 @@ func New(workerPoolSize int) (*Hub, error) {
 
 	return &Hub{
 		closeChan:       make(chan struct{}),
+		drainChan:       make(chan time.Duration),
 		clients:         make(map[uint64]Client),
 		pool:            pool,
 		subscriptions:   make(map[string]map[uint64]struct{}),
-		eventChan:       make(chan *event.Event, 1024),
+		filters:         make(map[string]map[interest.Filter]int),
+		eventChan:       make(chan message, 1024),
 		subscribeChan:   make(chan SubscribeEvent),
 		unsubscribeChan: make(chan UnsubscribeEvent),
 		registerChan:    make(chan Client),
@@ -76,9 +121,64 @@ func New(workerPoolSize int) (*Hub, error) {
 	}, nil
 }
 
-// Close closes a hub (not thread safe)
+// Close closes a hub immediately without closing its clients
 func (h *Hub) Close() {
-	close(h.closeChan)
+	h.closeOnce.Do(func() {
+		close(h.closeChan)
+	})
+}
+
+// Drain stops the hub from accepting new clients, registered clients keep receiving events
+func (h *Hub) Drain() {
+	h.draining.Store(true)
+}
+
+// IsDraining returns true if the hub is draining or closed
+func (h *Hub) IsDraining() bool {
+	return h.draining.Load()
+}
+
+// Shutdown drains the hub, delivers queued events, then shuts down every client and waits until all of them are unregistered.
+// The hub is closed when it returns, even if the context is done before all clients are gone.
+func (h *Hub) Shutdown(ctx context.Context, reconnectAfter time.Duration) error {
+	h.Drain()
+
+	select {
+	case h.drainChan <- reconnectAfter:
+	case <-h.closeChan:
+		return nil
+	case <-ctx.Done():
+		h.Close()
+
+		return ctx.Err()
+	}
+
+	select {
+	case <-h.closeChan:
+		return nil
+	case <-ctx.Done():
+		h.Close()
+
+		return ctx.Err()
+	}
+}
+
+// OnInterest sets a handler called whenever a filter of an event type gains its first subscriber or loses its last one.
+// It is called from the hub loop, so it must not block. It must be set before Run.
+func (h *Hub) OnInterest(handler func()) {
+	h.interestHandler = handler
+}
+
+// Interest returns filters of every event type that has at least one subscriber
+func (h *Hub) Interest() interest.Interest {
+	i, _ := h.interest.Load().(interest.Interest)
+
+	return i
+}
+
+// IsRunning returns true while the hub loop is running
+func (h *Hub) IsRunning() bool {
+	return h.running.Load()
 }
 
 // Register registers a client to the hub
@@ -103,31 +203,70 @@ func (h *Hub) Register(c Client) {
 		})
 	})
 
-	h.registerChan <- c
+	select {
+	case h.registerChan <- c:
+	case <-h.closeChan:
+	}
 }
 
 // UnRegister unregisters a client from the hub
 func (h *Hub) UnRegister(c Client) {
-	h.unregisterChan <- c
+	select {
+	case h.unregisterChan <- c:
+	case <-h.closeChan:
+	}
 }
 
 // Subscribe handle subscribing
 func (h *Hub) Subscribe(e SubscribeEvent) {
-	h.subscribeChan <- e
+	select {
+	case h.subscribeChan <- e:
+	case <-h.closeChan:
+	}
 }
 
 // Unsubscribe handle unsubscribing
 func (h *Hub) Unsubscribe(e UnsubscribeEvent) {
-	h.unsubscribeChan <- e
+	select {
+	case h.unsubscribeChan <- e:
+	case <-h.closeChan:
+	}
 }
 
 // SendEvent sends an event to clients
 func (h *Hub) SendEvent(e *event.Event) {
-	h.eventChan <- e
+	h.SendRound([]*event.Event{e})
+}
+
+// SendRound sends events of a round to clients, each client receives its matched events in one task
+func (h *Hub) SendRound(events []*event.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	select {
+	case h.eventChan <- message{events: events}:
+	case <-h.closeChan:
+	}
+}
+
+// Broadcast sends a control event to every client in order with other events.
+// The payload is sent with Send as it is, whatever the format and version of the client,
+// so clients that only receive typed events, e.g. gRPC streams, ignore it.
+func (h *Hub) Broadcast(e *event.Event) {
+	select {
+	case h.eventChan <- message{events: []*event.Event{e}, broadcast: true}:
+	case <-h.closeChan:
+	}
 }
 
 // Run runs worker to manage clients
 func (h *Hub) Run() {
+	h.running.Store(true)
+	defer h.running.Store(false)
+
+	shuttingDown := false
+	var reconnectAfter time.Duration
 	for {
 		select {
 		case <-h.closeChan:
@@ -138,61 +277,193 @@ func (h *Hub) Run() {
 			metrics.ActiveConnections.Set(float64(h.count.Load()))
 
 			log.Info().Msgf("hub: %v active sessions", h.count.Load())
+
+			if shuttingDown {
+				go shutdown(c, reconnectAfter)
+			}
 		case c := <-h.unregisterChan:
 			delete(h.clients, c.ID())
 			h.count.Dec()
 			metrics.ActiveConnections.Set(float64(h.count.Load()))
 
 			log.Info().Msgf("hub: %v active sessions", h.count.Load())
+
+			if shuttingDown && len(h.clients) == 0 {
+				h.Close()
+
+				return
+			}
+		case reconnectAfter = <-h.drainChan:
+			shuttingDown = true
+			h.flush()
+
+			log.Info().Msgf("hub: shutting down %v sessions", len(h.clients))
+
+			if len(h.clients) == 0 {
+				h.Close()
+
+				return
+			}
+
+			// clients unregister themselves through this loop once they are closed
+			for _, c := range h.clients {
+				go shutdown(c, reconnectAfter)
+			}
 		case e := <-h.subscribeChan:
+			changed := false
+			filter := h.filterOf(e.ClientID)
 			for _, evtType := range e.Types {
 				if _, exist := h.subscriptions[evtType]; !exist {
 					h.subscriptions[evtType] = make(map[uint64]struct{})
+					h.filters[evtType] = make(map[interest.Filter]int)
+				}
+
+				if _, exist := h.subscriptions[evtType][e.ClientID]; exist {
+					continue
 				}
 
 				h.subscriptions[evtType][e.ClientID] = struct{}{}
+				h.filters[evtType][filter]++
+				changed = changed || h.filters[evtType][filter] == 1
 			}
 
 			h.updateMetrics()
+			if changed {
+				h.updateInterest()
+			}
 		case e := <-h.unsubscribeChan:
+			changed := false
+			filter := h.filterOf(e.ClientID)
 			for _, evtType := range e.Types {
+				if _, exist := h.subscriptions[evtType][e.ClientID]; !exist {
+					continue
+				}
+
 				delete(h.subscriptions[evtType], e.ClientID)
+				h.filters[evtType][filter]--
+				if h.filters[evtType][filter] == 0 {
+					delete(h.filters[evtType], filter)
+					changed = true
+				}
+
 				if len(h.subscriptions[evtType]) == 0 {
 					delete(h.subscriptions, evtType)
+					delete(h.filters, evtType)
 				}
 
 				h.updateMetrics()
 			}
-		case event := <-h.eventChan:
-			var clients []Client
-			var wg sync.WaitGroup
-			for clientID := range h.subscriptions[event.Type] {
-				clients = append(clients, h.clients[clientID])
+
+			if changed {
+				h.updateInterest()
+			}
+		case m := <-h.eventChan:
+			h.dispatch(m)
+		}
+	}
+}
+
+// flush dispatches every queued event
+func (h *Hub) flush() {
+	for {
+		select {
+		case m := <-h.eventChan:
+			h.dispatch(m)
+		default:
+			return
+		}
+	}
+}
+
+func (h *Hub) dispatch(m message) {
+	var wg sync.WaitGroup
+	matched := make(map[uint64][]*event.Event)
+	if m.broadcast {
+		for clientID := range h.clients {
+			matched[clientID] = m.events
+		}
+	} else {
+		for _, e := range m.events {
+			for clientID := range h.subscriptions[e.Type] {
+				matched[clientID] = append(matched[clientID], e)
 			}
+		}
+	}
 
-			logger := log.With().Fields(map[string]interface{}{
-				"event_type":    event.Type,
-				"event_payload": string(event.Payload),
-			}).Logger()
+	logger := log.With().Fields(map[string]interface{}{
+		"round":  m.events[0].Round,
+		"events": len(m.events),
+	}).Logger()
 
-			for _, client := range clients {
-				client := client
-				wg.Add(1)
+	for clientID, events := range matched {
+		client := h.clients[clientID]
+		events := events
+		wg.Add(1)
 
-				err := h.pool.Submit(func() {
-					defer wg.Done()
+		err := h.pool.Submit(func() {
+			defer wg.Done()
 
-					client.Send(event.Payload)
-				})
-				if err != nil {
-					wg.Done()
-					logger.Error().Msg("hub: cannot submit task to the worker pool")
-				}
+			deliver(client, events, m.broadcast)
+		})
+		if err != nil {
+			wg.Done()
+			logger.Error().Msg("hub: cannot submit task to the worker pool")
+		}
+	}
+
+	wg.Wait()
+}
+
+// deliver sends events to a client through the richest interface it implements
+func deliver(c Client, events []*event.Event, broadcast bool) {
+	if !broadcast {
+		if r, ok := c.(RoundReceiver); ok {
+			r.SendRound(events)
+
+			return
+		}
+
+		if r, ok := c.(EventReceiver); ok {
+			for _, e := range events {
+				r.SendEvent(e)
 			}
 
-			wg.Wait()
+			return
+		}
+	}
+
+	for _, e := range events {
+		c.Send(e.Payload)
+	}
+}
+
+func shutdown(c Client, reconnectAfter time.Duration) {
+	if s, ok := c.(Shutdowner); ok {
+		s.Shutdown(reconnectAfter)
+	}
+}
+
+// filterOf returns a filter of a registered client, it is zero if the client receives every event of its types
+func (h *Hub) filterOf(clientID uint64) interest.Filter {
+	if f, ok := h.clients[clientID].(Filterer); ok {
+		return f.Filter()
+	}
+
+	return interest.Filter{}
+}
+
+func (h *Hub) updateInterest() {
+	i := make(interest.Interest, len(h.filters))
+	for evtType, filters := range h.filters {
+		for filter := range filters {
+			i.Add(evtType, filter)
 		}
 	}
+
+	h.interest.Store(i)
+	if h.interestHandler != nil {
+		h.interestHandler()
+	}
 }
 
 func (h *Hub) updateMetrics() {
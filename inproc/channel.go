@@ -0,0 +1,92 @@
+package inproc
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrClosed is returned when publishing to a closed channel
+	ErrClosed = errors.New("inproc: channel is closed")
+)
+
+// ProcessorFunc represents a processor function that consume message
+type ProcessorFunc[T any] func(message T)
+
+// Config represents a configuration of an in-process channel
+type Config[T any] struct {
+	// Size is a number of messages buffered before Publish blocks
+	Size      int
+	Processor ProcessorFunc[T]
+}
+
+// Channel delivers published messages to a processor in the same process, it replaces Redis in the standalone mode.
+// Messages are passed as they are, so they are not serialized.
+type Channel[T any] struct {
+	mu       sync.RWMutex
+	closed   bool
+	queue    chan T
+	doneChan chan struct{}
+}
+
+// New creates a new channel and starts processing messages
+func New[T any](conf Config[T]) *Channel[T] {
+	c := &Channel[T]{
+		queue:    make(chan T, conf.Size),
+		doneChan: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(c.doneChan)
+
+		for message := range c.queue {
+			conf.Processor(message)
+		}
+	}()
+
+	return c
+}
+
+// Publish queues a message for the processor
+func (c *Channel[T]) Publish(ctx context.Context, message T) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case c.queue <- message:
+		return nil
+	}
+}
+
+// Ping returns an error if the channel is closed
+func (c *Channel[T]) Ping(ctx context.Context) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+
+	return nil
+}
+
+// Close stops accepting messages and waits until the queued ones are processed
+func (c *Channel[T]) Close() error {
+	c.mu.Lock()
+	if !c.closed {
+		c.closed = true
+		close(c.queue)
+	}
+	c.mu.Unlock()
+
+	<-c.doneChan
+
+	return nil
+}
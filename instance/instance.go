@@ -0,0 +1,22 @@
+package instance
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+)
+
+// ID returns a unique identity of a process made of the hostname and a random suffix
+func ID() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+
+	bb := make([]byte, 4)
+	if _, err := rand.Read(bb); err != nil {
+		return "", err
+	}
+
+	return hostname + "-" + hex.EncodeToString(bb), nil
+}
@@ -0,0 +1,152 @@
+package interest
+
+import (
+	"sort"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+// Filter narrows events of a type down to an address, an asset or an application, zero fields match anything
+type Filter struct {
+	Address string `json:"address,omitempty"`
+	AssetID uint64 `json:"assetId,omitempty"`
+	AppID   uint64 `json:"appId,omitempty"`
+}
+
+// IsZero returns true if the filter matches every event
+func (f Filter) IsZero() bool {
+	return f == Filter{}
+}
+
+// Matches returns true if the event satisfies every field of the filter
+func (f Filter) Matches(e *event.Event) bool {
+	if f.Address != "" && !e.HasAddress(f.Address) {
+		return false
+	}
+
+	if f.AssetID != 0 && f.AssetID != e.AssetID {
+		return false
+	}
+
+	return f.AppID == 0 || f.AppID == e.AppID
+}
+
+// Interest maps event types to the distinct filters of their subscribers.
+// A type with a zero filter matches all of its events, so no other filter is kept for it.
+type Interest map[string][]Filter
+
+// Add adds a filter of a subscriber of an event type
+func (i Interest) Add(eventType string, f Filter) {
+	filters := i[eventType]
+	if len(filters) == 1 && filters[0].IsZero() {
+		return
+	}
+
+	if f.IsZero() {
+		i[eventType] = []Filter{f}
+
+		return
+	}
+
+	for _, existing := range filters {
+		if existing == f {
+			return
+		}
+	}
+
+	i[eventType] = append(filters, f)
+}
+
+// Merge adds every filter of another interest
+func (i Interest) Merge(other Interest) {
+	for eventType, filters := range other {
+		for _, f := range filters {
+			i.Add(eventType, f)
+		}
+	}
+}
+
+// Types returns sorted event types of the interest
+func (i Interest) Types() []string {
+	types := make([]string, 0, len(i))
+	for eventType := range i {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// Matches returns true if any filter of the event type matches the event
+func (i Interest) Matches(e *event.Event) bool {
+	for _, f := range i[e.Type] {
+		if f.Matches(e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matcher matches events against an interest, address filters are indexed since they are the most common ones
+type Matcher struct {
+	types map[string]*typeMatcher
+}
+
+type typeMatcher struct {
+	all       bool
+	addresses map[string]struct{}
+	filters   []Filter
+}
+
+// Compile creates a matcher of an interest
+func Compile(i Interest) *Matcher {
+	m := &Matcher{
+		types: make(map[string]*typeMatcher, len(i)),
+	}
+	for eventType, filters := range i {
+		tm := &typeMatcher{
+			addresses: make(map[string]struct{}),
+		}
+		for _, f := range filters {
+			switch {
+			case f.IsZero():
+				tm.all = true
+			case f.AssetID == 0 && f.AppID == 0:
+				tm.addresses[f.Address] = struct{}{}
+			default:
+				tm.filters = append(tm.filters, f)
+			}
+		}
+
+		m.types[eventType] = tm
+	}
+
+	return m
+}
+
+// Matches returns true if any filter of the event type matches the event
+func (m *Matcher) Matches(e *event.Event) bool {
+	tm, exist := m.types[e.Type]
+	if !exist {
+		return false
+	}
+
+	if tm.all {
+		return true
+	}
+
+	for _, address := range e.Addresses {
+		if _, exist := tm.addresses[address]; exist {
+			return true
+		}
+	}
+
+	for _, f := range tm.filters {
+		if f.Matches(e) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package interest
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/rs/zerolog/log"
+)
+
+// scanCount is a hint of keys returned by each SCAN call
+const scanCount = 100
+
+// RedisConfig represents a configuration for Redis interest registry
+type RedisConfig struct {
+	RedisHost     string
+	RedisPassword string
+	// Prefix is a prefix of keys holding the interest of each replica
+	Prefix string
+}
+
+// Redis keeps the interest of every server replica in Redis, each one in a key that expires unless it is advertised again
+type Redis struct {
+	conf RedisConfig
+	rdb  *redis.Client
+}
+
+// NewRedis creates a new Redis interest registry
+func NewRedis(conf RedisConfig) (*Redis, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     conf.RedisHost,
+		Password: conf.RedisPassword,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(10)*time.Second)
+	defer cancel()
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+
+	log.Info().Msg("interest: connected to Redis")
+
+	return &Redis{
+		conf: conf,
+		rdb:  rdb,
+	}, nil
+}
+
+// Close closes the Redis connection
+func (r *Redis) Close() error {
+	return r.rdb.Close()
+}
+
+// Ping checks that Redis is reachable
+func (r *Redis) Ping(ctx context.Context) error {
+	return r.rdb.Ping(ctx).Err()
+}
+
+// Advertise saves the interest of a replica for the ttl
+func (r *Redis) Advertise(ctx context.Context, replica string, i Interest, ttl time.Duration) error {
+	bb, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+
+	return r.rdb.Set(ctx, r.key(replica), bb, ttl).Err()
+}
+
+// Withdraw deletes the interest of a replica
+func (r *Redis) Withdraw(ctx context.Context, replica string) error {
+	return r.rdb.Del(ctx, r.key(replica)).Err()
+}
+
+// Load returns the interest of every replica that advertised it within its ttl
+func (r *Redis) Load(ctx context.Context) (map[string]Interest, error) {
+	var keys []string
+	iter := r.rdb.Scan(ctx, 0, r.conf.Prefix+":*", scanCount).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	interests := make(map[string]Interest, len(keys))
+	if len(keys) == 0 {
+		return interests, nil
+	}
+
+	values, err := r.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, v := range values {
+		// the key expired between SCAN and MGET
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		var i Interest
+		if err := json.Unmarshal([]byte(s), &i); err != nil {
+			log.Error().Err(err).Msgf("interest: ignored an invalid interest of %s", keys[idx])
+
+			continue
+		}
+
+		interests[strings.TrimPrefix(keys[idx], r.conf.Prefix+":")] = i
+	}
+
+	return interests, nil
+}
+
+func (r *Redis) key(replica string) string {
+	return r.conf.Prefix + ":" + replica
+}
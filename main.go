@@ -7,8 +7,10 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
+	"github.com/synycboom/algorand-notification/cmd/config"
 	"github.com/synycboom/algorand-notification/cmd/monitor"
 	"github.com/synycboom/algorand-notification/cmd/server"
+	"github.com/synycboom/algorand-notification/cmd/standalone"
 )
 
 var rootCmd = &cobra.Command{
@@ -22,8 +24,10 @@ var rootCmd = &cobra.Command{
 
 func main() {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	rootCmd.AddCommand(config.Command)
 	rootCmd.AddCommand(monitor.Command)
 	rootCmd.AddCommand(server.Command)
+	rootCmd.AddCommand(standalone.Command)
 	if err := rootCmd.Execute(); err != nil {
 		log.Error().Err(err).Msg("main: unexpected error")
 		os.Exit(1)
@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus metric names broken out for reuse.
+const (
+	LeaderName             = "leader"
+	LeaderTransitionsName  = "leader_transitions_total"
+	LeaderFencingTokenName = "leader_fencing_token"
+	RoutedBytesName        = "routed_bytes_total"
+	RoutingSavedBytesName  = "routing_saved_bytes_total"
+	RoutedReplicasName     = "routed_replicas"
+)
+
+// RegisterMonitorMetrics registers metrics related to the monitor
+func RegisterMonitorMetrics() {
+	prometheus.Register(Leader)
+	prometheus.Register(LeaderTransitions)
+	prometheus.Register(LeaderFencingToken)
+	prometheus.Register(RoutedBytes)
+	prometheus.Register(RoutingSavedBytes)
+	prometheus.Register(RoutedReplicas)
+}
+
+var (
+	Leader = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: "monitor",
+			Name:      LeaderName,
+			Help:      "1 if this monitor instance is the leader, 0 if it is a standby",
+		},
+	)
+
+	LeaderTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "monitor",
+			Name:      LeaderTransitionsName,
+			Help:      "Total leadership changes of this monitor instance by direction",
+		},
+		[]string{"direction"},
+	)
+
+	LeaderFencingToken = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: "monitor",
+			Name:      LeaderFencingTokenName,
+			Help:      "Fencing token of the current leadership term of this monitor instance",
+		},
+	)
+
+	RoutedBytes = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: "monitor",
+			Name:      RoutedBytesName,
+			Help:      "Total bytes of events published to server replicas in the routed mode",
+		},
+	)
+
+	RoutingSavedBytes = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: "monitor",
+			Name:      RoutingSavedBytesName,
+			Help:      "Total bytes not published to server replicas since no interest matched them",
+		},
+	)
+
+	RoutedReplicas = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: "monitor",
+			Name:      RoutedReplicasName,
+			Help:      "Number of server replicas with an advertised interest",
+		},
+	)
+)
+
+// ObserveRouted counts bytes published to a replica and bytes saved compared to publishing every event
+func ObserveRouted(routed, full int) {
+	RoutedBytes.Add(float64(routed))
+	RoutingSavedBytes.Add(float64(full - routed))
+}
+
+// ObserveLeadership sets the leader gauge and counts the transition
+func ObserveLeadership(leader bool, token uint64) {
+	direction := "lost"
+	value := float64(0)
+	if leader {
+		direction = "acquired"
+		value = 1
+		LeaderFencingToken.Set(float64(token))
+	}
+
+	Leader.Set(value)
+
+	metric, err := LeaderTransitions.GetMetricWith(prometheus.Labels{
+		"direction": direction,
+	})
+	if err != nil {
+		return
+	}
+
+	metric.Inc()
+}
@@ -8,12 +8,24 @@ import (
 const (
 	ActiveConnectionsName   = "active_connections"
 	ActiveSubscriptionsName = "active_subscription"
+	QuotaRejectionsName     = "quota_rejections_total"
+	DroppedRoundsName       = "dropped_rounds_total"
+	RoundGapsName           = "round_gaps_total"
+	SkippedRoundsName       = "skipped_rounds_total"
+	CompressionRawBytesName = "compression_raw_bytes_total"
+	CompressedBytesName     = "compressed_bytes_total"
 )
 
 // RegisterServerMetrics registers metrics related to the server
 func RegisterServerMetrics() {
 	prometheus.Register(ActiveConnections)
 	prometheus.Register(ActiveSubscriptions)
+	prometheus.Register(QuotaRejections)
+	prometheus.Register(DroppedRounds)
+	prometheus.Register(RoundGaps)
+	prometheus.Register(SkippedRounds)
+	prometheus.Register(CompressionRawBytes)
+	prometheus.Register(CompressedBytes)
 }
 
 var (
@@ -33,4 +45,108 @@ var (
 		},
 		[]string{"name"},
 	)
+
+	QuotaRejections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "server",
+			Name:      QuotaRejectionsName,
+			Help:      "Total rejections by quotas by tenant and reason",
+		},
+		[]string{"tenant", "reason"},
+	)
+
+	DroppedRounds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "server",
+			Name:      DroppedRoundsName,
+			Help:      "Total rounds discarded by channel and reason",
+		},
+		[]string{"channel", "reason"},
+	)
+
+	RoundGaps = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "server",
+			Name:      RoundGapsName,
+			Help:      "Total gaps of skipped rounds by channel",
+		},
+		[]string{"channel"},
+	)
+
+	SkippedRounds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "server",
+			Name:      SkippedRoundsName,
+			Help:      "Total rounds skipped by channel",
+		},
+		[]string{"channel"},
+	)
+
+	CompressionRawBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "server",
+			Name:      CompressionRawBytesName,
+			Help:      "Total bytes of compressed websocket events before compression by event type",
+		},
+		[]string{"name"},
+	)
+
+	CompressedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "server",
+			Name:      CompressedBytesName,
+			Help:      "Total bytes of compressed websocket events after compression by event type",
+		},
+		[]string{"name"},
+	)
 )
+
+// ObserveQuotaRejection increases quota rejections of a tenant
+func ObserveQuotaRejection(tenant, reason string) {
+	metric, err := QuotaRejections.GetMetricWith(prometheus.Labels{
+		"tenant": tenant,
+		"reason": reason,
+	})
+	if err != nil {
+		return
+	}
+
+	metric.Inc()
+}
+
+// ObserveDroppedRound increases dropped rounds of a channel
+func ObserveDroppedRound(channel, reason string) {
+	metric, err := DroppedRounds.GetMetricWith(prometheus.Labels{
+		"channel": channel,
+		"reason":  reason,
+	})
+	if err != nil {
+		return
+	}
+
+	metric.Inc()
+}
+
+// ObserveRoundGap increases round gaps and skipped rounds of a channel
+func ObserveRoundGap(channel string, skipped uint64) {
+	labels := prometheus.Labels{"channel": channel}
+	if metric, err := RoundGaps.GetMetricWith(labels); err == nil {
+		metric.Inc()
+	}
+
+	if metric, err := SkippedRounds.GetMetricWith(labels); err == nil {
+		metric.Add(float64(skipped))
+	}
+}
+
+// ObserveCompression increases raw and compressed bytes of an event type
+func ObserveCompression(eventType string, raw, compressed int) {
+	labels := prometheus.Labels{"name": eventType}
+	if metric, err := CompressionRawBytes.GetMetricWith(labels); err == nil {
+		metric.Add(float64(raw))
+	}
+
+	if metric, err := CompressedBytes.GetMetricWith(labels); err == nil {
+		metric.Add(float64(compressed))
+	}
+}
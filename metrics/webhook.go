@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus metric names broken out for reuse.
+const (
+	WebhookDeliveriesName       = "deliveries_total"
+	WebhookDeliveryDurationName = "delivery_duration_seconds"
+	WebhookDeadLettersName      = "dead_letters_total"
+)
+
+// RegisterWebhookMetrics registers metrics related to webhook delivery
+func RegisterWebhookMetrics() {
+	prometheus.Register(WebhookDeliveries)
+	prometheus.Register(WebhookDeliveryDuration)
+	prometheus.Register(WebhookDeadLetters)
+}
+
+var (
+	WebhookDeliveries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "webhook",
+			Name:      WebhookDeliveriesName,
+			Help:      "Total delivery attempts by endpoint and result",
+		},
+		[]string{"endpoint", "result"},
+	)
+
+	WebhookDeliveryDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: "webhook",
+			Name:      WebhookDeliveryDurationName,
+			Help:      "Duration of delivery attempts by endpoint",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"endpoint"},
+	)
+
+	WebhookDeadLetters = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "webhook",
+			Name:      WebhookDeadLettersName,
+			Help:      "Total events moved to the dead-letter list by endpoint",
+		},
+		[]string{"endpoint"},
+	)
+)
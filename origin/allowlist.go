@@ -0,0 +1,69 @@
+package origin
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Allowlist checks origins of websocket upgrade requests
+type Allowlist struct {
+	mu       sync.RWMutex
+	patterns []string
+}
+
+// New creates an allowlist of exact origins (https://app.example.com) and wildcard origins (https://*.example.com).
+// "*" allows every origin.
+func New(patterns []string) *Allowlist {
+	a := &Allowlist{}
+	a.Set(patterns)
+
+	return a
+}
+
+// Set replaces the patterns
+func (a *Allowlist) Set(patterns []string) {
+	normalized := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		normalized = append(normalized, strings.ToLower(strings.TrimSuffix(p, "/")))
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.patterns = normalized
+}
+
+// Allowed returns true if the origin matches one of the patterns
+func (a *Allowlist) Allowed(origin string) bool {
+	origin = strings.ToLower(origin)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	for _, p := range a.patterns {
+		if p == "*" || p == origin {
+			return true
+		}
+
+		// a wildcard only matches subdomains, e.g. https://*.example.com matches https://a.example.com
+		if i := strings.Index(p, "*."); i >= 0 {
+			prefix, suffix := p[:i], p[i+1:]
+			if strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) && len(origin) > len(prefix)+len(suffix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CheckOrigin is used by the websocket upgrader, requests without an Origin header come from non-browser clients and are allowed
+func (a *Allowlist) CheckOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	return a.Allowed(origin)
+}
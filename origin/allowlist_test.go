@@ -0,0 +1,77 @@
+package origin
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		origin   string
+		want     bool
+	}{
+		{name: "empty allowlist", patterns: nil, origin: "https://app.example.com", want: false},
+		{name: "any origin", patterns: []string{"*"}, origin: "https://evil.com", want: true},
+		{name: "exact", patterns: []string{"https://app.example.com"}, origin: "https://app.example.com", want: true},
+		{name: "exact with a trailing slash", patterns: []string{"https://app.example.com/"}, origin: "https://app.example.com", want: true},
+		{name: "case insensitive", patterns: []string{"https://App.Example.com"}, origin: "HTTPS://app.example.COM", want: true},
+		{name: "other scheme", patterns: []string{"https://app.example.com"}, origin: "http://app.example.com", want: false},
+		{name: "other port", patterns: []string{"https://app.example.com"}, origin: "https://app.example.com:8443", want: false},
+		{name: "exact with a port", patterns: []string{"http://localhost:3000"}, origin: "http://localhost:3000", want: true},
+		{name: "subdomain", patterns: []string{"https://*.example.com"}, origin: "https://a.example.com", want: true},
+		{name: "nested subdomain", patterns: []string{"https://*.example.com"}, origin: "https://a.b.example.com", want: true},
+		{name: "wildcard does not match the apex", patterns: []string{"https://*.example.com"}, origin: "https://example.com", want: false},
+		{name: "wildcard does not match an empty label", patterns: []string{"https://*.example.com"}, origin: "https://.example.com", want: false},
+		{name: "wildcard scheme", patterns: []string{"https://*.example.com"}, origin: "http://a.example.com", want: false},
+		{name: "suffix of another domain", patterns: []string{"https://*.example.com"}, origin: "https://a.notexample.com", want: false},
+		{name: "domain with the same suffix", patterns: []string{"https://*.example.com"}, origin: "https://example.com.evil.com", want: false},
+		{name: "wildcard with a port", patterns: []string{"https://*.example.com:8443"}, origin: "https://a.example.com:8443", want: true},
+		{name: "wildcard with another port", patterns: []string{"https://*.example.com:8443"}, origin: "https://a.example.com", want: false},
+		{name: "one of many", patterns: []string{"https://a.com", "https://*.b.com"}, origin: "https://x.b.com", want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := New(tc.patterns).Allowed(tc.origin); got != tc.want {
+				t.Fatalf("Allowed(%q) = %v, want %v", tc.origin, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	a := New([]string{"https://a.com"})
+	a.Set([]string{"https://b.com"})
+
+	if a.Allowed("https://a.com") || !a.Allowed("https://b.com") {
+		t.Fatal("patterns were not replaced")
+	}
+}
+
+func TestCheckOrigin(t *testing.T) {
+	tests := []struct {
+		name   string
+		origin string
+		want   bool
+	}{
+		{name: "non-browser client", origin: "", want: true},
+		{name: "allowed", origin: "https://a.example.com", want: true},
+		{name: "not allowed", origin: "https://evil.com", want: false},
+	}
+
+	a := New([]string{"https://*.example.com"})
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+
+			if got := a.CheckOrigin(r); got != tc.want {
+				t.Fatalf("CheckOrigin = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
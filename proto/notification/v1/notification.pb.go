@@ -0,0 +1,3410 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        (unknown)
+// source: notification/v1/notification.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SubscribeRequest is a subscription detail
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// events are event types, e.g. NEW_BLOCK or NEW_PAYMENT_TX
+	Events []string `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// address optionally limits transaction events to the ones involving the address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// asset_id optionally limits transaction events to the ones of the asset
+	AssetId uint64 `protobuf:"varint,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// app_id optionally limits transaction events to the ones calling the application
+	AppId uint64 `protobuf:"varint,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetAssetId() uint64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+// Event is an event with its typed data
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Seq       uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Round     uint64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// Types that are assignable to Data:
+	//	*Event_Block
+	//	*Event_Transaction
+	Data isEvent_Data `protobuf_oneof:"data"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *Event) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Event) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (m *Event) GetData() isEvent_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *Event) GetBlock() *Block {
+	if x, ok := x.GetData().(*Event_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *Event) GetTransaction() *Transaction {
+	if x, ok := x.GetData().(*Event_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+type isEvent_Data interface {
+	isEvent_Data()
+}
+
+type Event_Block struct {
+	Block *Block `protobuf:"bytes,4,opt,name=block,proto3,oneof"`
+}
+
+type Event_Transaction struct {
+	Transaction *Transaction `protobuf:"bytes,5,opt,name=transaction,proto3,oneof"`
+}
+
+func (*Event_Block) isEvent_Data() {}
+
+func (*Event_Transaction) isEvent_Data() {}
+
+// Block is the data of a NEW_BLOCK or NEW_BLOCK_HEADER event
+type Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GenesisHash            []byte                `protobuf:"bytes,1,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	GenesisId              string                `protobuf:"bytes,2,opt,name=genesis_id,json=genesisId,proto3" json:"genesis_id,omitempty"`
+	ParticipationUpdates   *ParticipationUpdates `protobuf:"bytes,3,opt,name=participation_updates,json=participationUpdates,proto3" json:"participation_updates,omitempty"`
+	PreviousBlockHash      []byte                `protobuf:"bytes,4,opt,name=previous_block_hash,json=previousBlockHash,proto3" json:"previous_block_hash,omitempty"`
+	Rewards                *BlockRewards         `protobuf:"bytes,5,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	Round                  uint64                `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	Seed                   []byte                `protobuf:"bytes,7,opt,name=seed,proto3" json:"seed,omitempty"`
+	StateProofTracking     []*StateProofTracking `protobuf:"bytes,8,rep,name=state_proof_tracking,json=stateProofTracking,proto3" json:"state_proof_tracking,omitempty"`
+	Timestamp              uint64                `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Transactions           []*Transaction        `protobuf:"bytes,10,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	TransactionsRoot       []byte                `protobuf:"bytes,11,opt,name=transactions_root,json=transactionsRoot,proto3" json:"transactions_root,omitempty"`
+	TransactionsRootSha256 []byte                `protobuf:"bytes,12,opt,name=transactions_root_sha256,json=transactionsRootSha256,proto3" json:"transactions_root_sha256,omitempty"`
+	TxnCounter             uint64                `protobuf:"varint,13,opt,name=txn_counter,json=txnCounter,proto3" json:"txn_counter,omitempty"`
+	UpgradeState           *BlockUpgradeState    `protobuf:"bytes,14,opt,name=upgrade_state,json=upgradeState,proto3" json:"upgrade_state,omitempty"`
+	UpgradeVote            *BlockUpgradeVote     `protobuf:"bytes,15,opt,name=upgrade_vote,json=upgradeVote,proto3" json:"upgrade_vote,omitempty"`
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Block) GetGenesisHash() []byte {
+	if x != nil {
+		return x.GenesisHash
+	}
+	return nil
+}
+
+func (x *Block) GetGenesisId() string {
+	if x != nil {
+		return x.GenesisId
+	}
+	return ""
+}
+
+func (x *Block) GetParticipationUpdates() *ParticipationUpdates {
+	if x != nil {
+		return x.ParticipationUpdates
+	}
+	return nil
+}
+
+func (x *Block) GetPreviousBlockHash() []byte {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return nil
+}
+
+func (x *Block) GetRewards() *BlockRewards {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *Block) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Block) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *Block) GetStateProofTracking() []*StateProofTracking {
+	if x != nil {
+		return x.StateProofTracking
+	}
+	return nil
+}
+
+func (x *Block) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Block) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *Block) GetTransactionsRoot() []byte {
+	if x != nil {
+		return x.TransactionsRoot
+	}
+	return nil
+}
+
+func (x *Block) GetTransactionsRootSha256() []byte {
+	if x != nil {
+		return x.TransactionsRootSha256
+	}
+	return nil
+}
+
+func (x *Block) GetTxnCounter() uint64 {
+	if x != nil {
+		return x.TxnCounter
+	}
+	return 0
+}
+
+func (x *Block) GetUpgradeState() *BlockUpgradeState {
+	if x != nil {
+		return x.UpgradeState
+	}
+	return nil
+}
+
+func (x *Block) GetUpgradeVote() *BlockUpgradeVote {
+	if x != nil {
+		return x.UpgradeVote
+	}
+	return nil
+}
+
+type BlockRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeeSink                 string `protobuf:"bytes,1,opt,name=fee_sink,json=feeSink,proto3" json:"fee_sink,omitempty"`
+	RewardsCalculationRound uint64 `protobuf:"varint,2,opt,name=rewards_calculation_round,json=rewardsCalculationRound,proto3" json:"rewards_calculation_round,omitempty"`
+	RewardsLevel            uint64 `protobuf:"varint,3,opt,name=rewards_level,json=rewardsLevel,proto3" json:"rewards_level,omitempty"`
+	RewardsPool             string `protobuf:"bytes,4,opt,name=rewards_pool,json=rewardsPool,proto3" json:"rewards_pool,omitempty"`
+	RewardsRate             uint64 `protobuf:"varint,5,opt,name=rewards_rate,json=rewardsRate,proto3" json:"rewards_rate,omitempty"`
+	RewardsResidue          uint64 `protobuf:"varint,6,opt,name=rewards_residue,json=rewardsResidue,proto3" json:"rewards_residue,omitempty"`
+}
+
+func (x *BlockRewards) Reset() {
+	*x = BlockRewards{}
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRewards) ProtoMessage() {}
+
+func (x *BlockRewards) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRewards.ProtoReflect.Descriptor instead.
+func (*BlockRewards) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockRewards) GetFeeSink() string {
+	if x != nil {
+		return x.FeeSink
+	}
+	return ""
+}
+
+func (x *BlockRewards) GetRewardsCalculationRound() uint64 {
+	if x != nil {
+		return x.RewardsCalculationRound
+	}
+	return 0
+}
+
+func (x *BlockRewards) GetRewardsLevel() uint64 {
+	if x != nil {
+		return x.RewardsLevel
+	}
+	return 0
+}
+
+func (x *BlockRewards) GetRewardsPool() string {
+	if x != nil {
+		return x.RewardsPool
+	}
+	return ""
+}
+
+func (x *BlockRewards) GetRewardsRate() uint64 {
+	if x != nil {
+		return x.RewardsRate
+	}
+	return 0
+}
+
+func (x *BlockRewards) GetRewardsResidue() uint64 {
+	if x != nil {
+		return x.RewardsResidue
+	}
+	return 0
+}
+
+type BlockUpgradeState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentProtocol        string `protobuf:"bytes,1,opt,name=current_protocol,json=currentProtocol,proto3" json:"current_protocol,omitempty"`
+	NextProtocol           string `protobuf:"bytes,2,opt,name=next_protocol,json=nextProtocol,proto3" json:"next_protocol,omitempty"`
+	NextProtocolApprovals  uint64 `protobuf:"varint,3,opt,name=next_protocol_approvals,json=nextProtocolApprovals,proto3" json:"next_protocol_approvals,omitempty"`
+	NextProtocolSwitchOn   uint64 `protobuf:"varint,4,opt,name=next_protocol_switch_on,json=nextProtocolSwitchOn,proto3" json:"next_protocol_switch_on,omitempty"`
+	NextProtocolVoteBefore uint64 `protobuf:"varint,5,opt,name=next_protocol_vote_before,json=nextProtocolVoteBefore,proto3" json:"next_protocol_vote_before,omitempty"`
+}
+
+func (x *BlockUpgradeState) Reset() {
+	*x = BlockUpgradeState{}
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUpgradeState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUpgradeState) ProtoMessage() {}
+
+func (x *BlockUpgradeState) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUpgradeState.ProtoReflect.Descriptor instead.
+func (*BlockUpgradeState) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BlockUpgradeState) GetCurrentProtocol() string {
+	if x != nil {
+		return x.CurrentProtocol
+	}
+	return ""
+}
+
+func (x *BlockUpgradeState) GetNextProtocol() string {
+	if x != nil {
+		return x.NextProtocol
+	}
+	return ""
+}
+
+func (x *BlockUpgradeState) GetNextProtocolApprovals() uint64 {
+	if x != nil {
+		return x.NextProtocolApprovals
+	}
+	return 0
+}
+
+func (x *BlockUpgradeState) GetNextProtocolSwitchOn() uint64 {
+	if x != nil {
+		return x.NextProtocolSwitchOn
+	}
+	return 0
+}
+
+func (x *BlockUpgradeState) GetNextProtocolVoteBefore() uint64 {
+	if x != nil {
+		return x.NextProtocolVoteBefore
+	}
+	return 0
+}
+
+type BlockUpgradeVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpgradeApprove bool   `protobuf:"varint,1,opt,name=upgrade_approve,json=upgradeApprove,proto3" json:"upgrade_approve,omitempty"`
+	UpgradeDelay   uint64 `protobuf:"varint,2,opt,name=upgrade_delay,json=upgradeDelay,proto3" json:"upgrade_delay,omitempty"`
+	UpgradePropose string `protobuf:"bytes,3,opt,name=upgrade_propose,json=upgradePropose,proto3" json:"upgrade_propose,omitempty"`
+}
+
+func (x *BlockUpgradeVote) Reset() {
+	*x = BlockUpgradeVote{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUpgradeVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUpgradeVote) ProtoMessage() {}
+
+func (x *BlockUpgradeVote) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUpgradeVote.ProtoReflect.Descriptor instead.
+func (*BlockUpgradeVote) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BlockUpgradeVote) GetUpgradeApprove() bool {
+	if x != nil {
+		return x.UpgradeApprove
+	}
+	return false
+}
+
+func (x *BlockUpgradeVote) GetUpgradeDelay() uint64 {
+	if x != nil {
+		return x.UpgradeDelay
+	}
+	return 0
+}
+
+func (x *BlockUpgradeVote) GetUpgradePropose() string {
+	if x != nil {
+		return x.UpgradePropose
+	}
+	return ""
+}
+
+type ParticipationUpdates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiredParticipationAccounts []string `protobuf:"bytes,1,rep,name=expired_participation_accounts,json=expiredParticipationAccounts,proto3" json:"expired_participation_accounts,omitempty"`
+}
+
+func (x *ParticipationUpdates) Reset() {
+	*x = ParticipationUpdates{}
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipationUpdates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipationUpdates) ProtoMessage() {}
+
+func (x *ParticipationUpdates) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipationUpdates.ProtoReflect.Descriptor instead.
+func (*ParticipationUpdates) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ParticipationUpdates) GetExpiredParticipationAccounts() []string {
+	if x != nil {
+		return x.ExpiredParticipationAccounts
+	}
+	return nil
+}
+
+type StateProofTracking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextRound         uint64 `protobuf:"varint,1,opt,name=next_round,json=nextRound,proto3" json:"next_round,omitempty"`
+	OnlineTotalWeight uint64 `protobuf:"varint,2,opt,name=online_total_weight,json=onlineTotalWeight,proto3" json:"online_total_weight,omitempty"`
+	Type              uint64 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	VotersCommitment  []byte `protobuf:"bytes,4,opt,name=voters_commitment,json=votersCommitment,proto3" json:"voters_commitment,omitempty"`
+}
+
+func (x *StateProofTracking) Reset() {
+	*x = StateProofTracking{}
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProofTracking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProofTracking) ProtoMessage() {}
+
+func (x *StateProofTracking) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProofTracking.ProtoReflect.Descriptor instead.
+func (*StateProofTracking) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StateProofTracking) GetNextRound() uint64 {
+	if x != nil {
+		return x.NextRound
+	}
+	return 0
+}
+
+func (x *StateProofTracking) GetOnlineTotalWeight() uint64 {
+	if x != nil {
+		return x.OnlineTotalWeight
+	}
+	return 0
+}
+
+func (x *StateProofTracking) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *StateProofTracking) GetVotersCommitment() []byte {
+	if x != nil {
+		return x.VotersCommitment
+	}
+	return nil
+}
+
+// Transaction is the data of transaction events, only the field matched with tx_type is set
+type Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationTransaction   *TransactionApplication   `protobuf:"bytes,1,opt,name=application_transaction,json=applicationTransaction,proto3" json:"application_transaction,omitempty"`
+	AssetConfigTransaction   *TransactionAssetConfig   `protobuf:"bytes,2,opt,name=asset_config_transaction,json=assetConfigTransaction,proto3" json:"asset_config_transaction,omitempty"`
+	AssetFreezeTransaction   *TransactionAssetFreeze   `protobuf:"bytes,3,opt,name=asset_freeze_transaction,json=assetFreezeTransaction,proto3" json:"asset_freeze_transaction,omitempty"`
+	AssetTransferTransaction *TransactionAssetTransfer `protobuf:"bytes,4,opt,name=asset_transfer_transaction,json=assetTransferTransaction,proto3" json:"asset_transfer_transaction,omitempty"`
+	AuthAddr                 string                    `protobuf:"bytes,5,opt,name=auth_addr,json=authAddr,proto3" json:"auth_addr,omitempty"`
+	CloseRewards             uint64                    `protobuf:"varint,6,opt,name=close_rewards,json=closeRewards,proto3" json:"close_rewards,omitempty"`
+	ClosingAmount            uint64                    `protobuf:"varint,7,opt,name=closing_amount,json=closingAmount,proto3" json:"closing_amount,omitempty"`
+	ConfirmedRound           uint64                    `protobuf:"varint,8,opt,name=confirmed_round,json=confirmedRound,proto3" json:"confirmed_round,omitempty"`
+	CreatedApplicationIndex  uint64                    `protobuf:"varint,9,opt,name=created_application_index,json=createdApplicationIndex,proto3" json:"created_application_index,omitempty"`
+	CreatedAssetIndex        uint64                    `protobuf:"varint,10,opt,name=created_asset_index,json=createdAssetIndex,proto3" json:"created_asset_index,omitempty"`
+	Fee                      uint64                    `protobuf:"varint,11,opt,name=fee,proto3" json:"fee,omitempty"`
+	FirstValid               uint64                    `protobuf:"varint,12,opt,name=first_valid,json=firstValid,proto3" json:"first_valid,omitempty"`
+	GenesisHash              []byte                    `protobuf:"bytes,13,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	GenesisId                string                    `protobuf:"bytes,14,opt,name=genesis_id,json=genesisId,proto3" json:"genesis_id,omitempty"`
+	GlobalStateDelta         []*EvalDeltaKeyValue      `protobuf:"bytes,15,rep,name=global_state_delta,json=globalStateDelta,proto3" json:"global_state_delta,omitempty"`
+	Group                    []byte                    `protobuf:"bytes,16,opt,name=group,proto3" json:"group,omitempty"`
+	Id                       string                    `protobuf:"bytes,17,opt,name=id,proto3" json:"id,omitempty"`
+	InnerTxns                []*Transaction            `protobuf:"bytes,18,rep,name=inner_txns,json=innerTxns,proto3" json:"inner_txns,omitempty"`
+	IntraRoundOffset         uint64                    `protobuf:"varint,19,opt,name=intra_round_offset,json=intraRoundOffset,proto3" json:"intra_round_offset,omitempty"`
+	KeyregTransaction        *TransactionKeyreg        `protobuf:"bytes,20,opt,name=keyreg_transaction,json=keyregTransaction,proto3" json:"keyreg_transaction,omitempty"`
+	LastValid                uint64                    `protobuf:"varint,21,opt,name=last_valid,json=lastValid,proto3" json:"last_valid,omitempty"`
+	Lease                    []byte                    `protobuf:"bytes,22,opt,name=lease,proto3" json:"lease,omitempty"`
+	LocalStateDelta          []*AccountStateDelta      `protobuf:"bytes,23,rep,name=local_state_delta,json=localStateDelta,proto3" json:"local_state_delta,omitempty"`
+	Logs                     [][]byte                  `protobuf:"bytes,24,rep,name=logs,proto3" json:"logs,omitempty"`
+	Note                     []byte                    `protobuf:"bytes,25,opt,name=note,proto3" json:"note,omitempty"`
+	PaymentTransaction       *TransactionPayment       `protobuf:"bytes,26,opt,name=payment_transaction,json=paymentTransaction,proto3" json:"payment_transaction,omitempty"`
+	ReceiverRewards          uint64                    `protobuf:"varint,27,opt,name=receiver_rewards,json=receiverRewards,proto3" json:"receiver_rewards,omitempty"`
+	RekeyTo                  string                    `protobuf:"bytes,28,opt,name=rekey_to,json=rekeyTo,proto3" json:"rekey_to,omitempty"`
+	RoundTime                uint64                    `protobuf:"varint,29,opt,name=round_time,json=roundTime,proto3" json:"round_time,omitempty"`
+	Sender                   string                    `protobuf:"bytes,30,opt,name=sender,proto3" json:"sender,omitempty"`
+	SenderRewards            uint64                    `protobuf:"varint,31,opt,name=sender_rewards,json=senderRewards,proto3" json:"sender_rewards,omitempty"`
+	Signature                *TransactionSignature     `protobuf:"bytes,32,opt,name=signature,proto3" json:"signature,omitempty"`
+	StateProofTransaction    *TransactionStateProof    `protobuf:"bytes,33,opt,name=state_proof_transaction,json=stateProofTransaction,proto3" json:"state_proof_transaction,omitempty"`
+	TxType                   string                    `protobuf:"bytes,34,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Transaction) GetApplicationTransaction() *TransactionApplication {
+	if x != nil {
+		return x.ApplicationTransaction
+	}
+	return nil
+}
+
+func (x *Transaction) GetAssetConfigTransaction() *TransactionAssetConfig {
+	if x != nil {
+		return x.AssetConfigTransaction
+	}
+	return nil
+}
+
+func (x *Transaction) GetAssetFreezeTransaction() *TransactionAssetFreeze {
+	if x != nil {
+		return x.AssetFreezeTransaction
+	}
+	return nil
+}
+
+func (x *Transaction) GetAssetTransferTransaction() *TransactionAssetTransfer {
+	if x != nil {
+		return x.AssetTransferTransaction
+	}
+	return nil
+}
+
+func (x *Transaction) GetAuthAddr() string {
+	if x != nil {
+		return x.AuthAddr
+	}
+	return ""
+}
+
+func (x *Transaction) GetCloseRewards() uint64 {
+	if x != nil {
+		return x.CloseRewards
+	}
+	return 0
+}
+
+func (x *Transaction) GetClosingAmount() uint64 {
+	if x != nil {
+		return x.ClosingAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetConfirmedRound() uint64 {
+	if x != nil {
+		return x.ConfirmedRound
+	}
+	return 0
+}
+
+func (x *Transaction) GetCreatedApplicationIndex() uint64 {
+	if x != nil {
+		return x.CreatedApplicationIndex
+	}
+	return 0
+}
+
+func (x *Transaction) GetCreatedAssetIndex() uint64 {
+	if x != nil {
+		return x.CreatedAssetIndex
+	}
+	return 0
+}
+
+func (x *Transaction) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Transaction) GetFirstValid() uint64 {
+	if x != nil {
+		return x.FirstValid
+	}
+	return 0
+}
+
+func (x *Transaction) GetGenesisHash() []byte {
+	if x != nil {
+		return x.GenesisHash
+	}
+	return nil
+}
+
+func (x *Transaction) GetGenesisId() string {
+	if x != nil {
+		return x.GenesisId
+	}
+	return ""
+}
+
+func (x *Transaction) GetGlobalStateDelta() []*EvalDeltaKeyValue {
+	if x != nil {
+		return x.GlobalStateDelta
+	}
+	return nil
+}
+
+func (x *Transaction) GetGroup() []byte {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *Transaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction) GetInnerTxns() []*Transaction {
+	if x != nil {
+		return x.InnerTxns
+	}
+	return nil
+}
+
+func (x *Transaction) GetIntraRoundOffset() uint64 {
+	if x != nil {
+		return x.IntraRoundOffset
+	}
+	return 0
+}
+
+func (x *Transaction) GetKeyregTransaction() *TransactionKeyreg {
+	if x != nil {
+		return x.KeyregTransaction
+	}
+	return nil
+}
+
+func (x *Transaction) GetLastValid() uint64 {
+	if x != nil {
+		return x.LastValid
+	}
+	return 0
+}
+
+func (x *Transaction) GetLease() []byte {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+func (x *Transaction) GetLocalStateDelta() []*AccountStateDelta {
+	if x != nil {
+		return x.LocalStateDelta
+	}
+	return nil
+}
+
+func (x *Transaction) GetLogs() [][]byte {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *Transaction) GetNote() []byte {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *Transaction) GetPaymentTransaction() *TransactionPayment {
+	if x != nil {
+		return x.PaymentTransaction
+	}
+	return nil
+}
+
+func (x *Transaction) GetReceiverRewards() uint64 {
+	if x != nil {
+		return x.ReceiverRewards
+	}
+	return 0
+}
+
+func (x *Transaction) GetRekeyTo() string {
+	if x != nil {
+		return x.RekeyTo
+	}
+	return ""
+}
+
+func (x *Transaction) GetRoundTime() uint64 {
+	if x != nil {
+		return x.RoundTime
+	}
+	return 0
+}
+
+func (x *Transaction) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Transaction) GetSenderRewards() uint64 {
+	if x != nil {
+		return x.SenderRewards
+	}
+	return 0
+}
+
+func (x *Transaction) GetSignature() *TransactionSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Transaction) GetStateProofTransaction() *TransactionStateProof {
+	if x != nil {
+		return x.StateProofTransaction
+	}
+	return nil
+}
+
+func (x *Transaction) GetTxType() string {
+	if x != nil {
+		return x.TxType
+	}
+	return ""
+}
+
+type TransactionPayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount           uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CloseAmount      uint64 `protobuf:"varint,2,opt,name=close_amount,json=closeAmount,proto3" json:"close_amount,omitempty"`
+	CloseRemainderTo string `protobuf:"bytes,3,opt,name=close_remainder_to,json=closeRemainderTo,proto3" json:"close_remainder_to,omitempty"`
+	Receiver         string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (x *TransactionPayment) Reset() {
+	*x = TransactionPayment{}
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionPayment) ProtoMessage() {}
+
+func (x *TransactionPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionPayment.ProtoReflect.Descriptor instead.
+func (*TransactionPayment) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransactionPayment) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionPayment) GetCloseAmount() uint64 {
+	if x != nil {
+		return x.CloseAmount
+	}
+	return 0
+}
+
+func (x *TransactionPayment) GetCloseRemainderTo() string {
+	if x != nil {
+		return x.CloseRemainderTo
+	}
+	return ""
+}
+
+func (x *TransactionPayment) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+type TransactionKeyreg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NonParticipation          bool   `protobuf:"varint,1,opt,name=non_participation,json=nonParticipation,proto3" json:"non_participation,omitempty"`
+	SelectionParticipationKey []byte `protobuf:"bytes,2,opt,name=selection_participation_key,json=selectionParticipationKey,proto3" json:"selection_participation_key,omitempty"`
+	StateProofKey             []byte `protobuf:"bytes,3,opt,name=state_proof_key,json=stateProofKey,proto3" json:"state_proof_key,omitempty"`
+	VoteFirstValid            uint64 `protobuf:"varint,4,opt,name=vote_first_valid,json=voteFirstValid,proto3" json:"vote_first_valid,omitempty"`
+	VoteKeyDilution           uint64 `protobuf:"varint,5,opt,name=vote_key_dilution,json=voteKeyDilution,proto3" json:"vote_key_dilution,omitempty"`
+	VoteLastValid             uint64 `protobuf:"varint,6,opt,name=vote_last_valid,json=voteLastValid,proto3" json:"vote_last_valid,omitempty"`
+	VoteParticipationKey      []byte `protobuf:"bytes,7,opt,name=vote_participation_key,json=voteParticipationKey,proto3" json:"vote_participation_key,omitempty"`
+}
+
+func (x *TransactionKeyreg) Reset() {
+	*x = TransactionKeyreg{}
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionKeyreg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionKeyreg) ProtoMessage() {}
+
+func (x *TransactionKeyreg) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionKeyreg.ProtoReflect.Descriptor instead.
+func (*TransactionKeyreg) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransactionKeyreg) GetNonParticipation() bool {
+	if x != nil {
+		return x.NonParticipation
+	}
+	return false
+}
+
+func (x *TransactionKeyreg) GetSelectionParticipationKey() []byte {
+	if x != nil {
+		return x.SelectionParticipationKey
+	}
+	return nil
+}
+
+func (x *TransactionKeyreg) GetStateProofKey() []byte {
+	if x != nil {
+		return x.StateProofKey
+	}
+	return nil
+}
+
+func (x *TransactionKeyreg) GetVoteFirstValid() uint64 {
+	if x != nil {
+		return x.VoteFirstValid
+	}
+	return 0
+}
+
+func (x *TransactionKeyreg) GetVoteKeyDilution() uint64 {
+	if x != nil {
+		return x.VoteKeyDilution
+	}
+	return 0
+}
+
+func (x *TransactionKeyreg) GetVoteLastValid() uint64 {
+	if x != nil {
+		return x.VoteLastValid
+	}
+	return 0
+}
+
+func (x *TransactionKeyreg) GetVoteParticipationKey() []byte {
+	if x != nil {
+		return x.VoteParticipationKey
+	}
+	return nil
+}
+
+type TransactionAssetConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId uint64       `protobuf:"varint,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Params  *AssetParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *TransactionAssetConfig) Reset() {
+	*x = TransactionAssetConfig{}
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionAssetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionAssetConfig) ProtoMessage() {}
+
+func (x *TransactionAssetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionAssetConfig.ProtoReflect.Descriptor instead.
+func (*TransactionAssetConfig) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransactionAssetConfig) GetAssetId() uint64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *TransactionAssetConfig) GetParams() *AssetParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type AssetParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clawback      string `protobuf:"bytes,1,opt,name=clawback,proto3" json:"clawback,omitempty"`
+	Creator       string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Decimals      uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	DefaultFrozen bool   `protobuf:"varint,4,opt,name=default_frozen,json=defaultFrozen,proto3" json:"default_frozen,omitempty"`
+	Freeze        string `protobuf:"bytes,5,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	Manager       string `protobuf:"bytes,6,opt,name=manager,proto3" json:"manager,omitempty"`
+	MetadataHash  []byte `protobuf:"bytes,7,opt,name=metadata_hash,json=metadataHash,proto3" json:"metadata_hash,omitempty"`
+	Name          string `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	NameB64       []byte `protobuf:"bytes,9,opt,name=name_b64,json=nameB64,proto3" json:"name_b64,omitempty"`
+	Reserve       string `protobuf:"bytes,10,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	Total         uint64 `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`
+	UnitName      string `protobuf:"bytes,12,opt,name=unit_name,json=unitName,proto3" json:"unit_name,omitempty"`
+	UnitNameB64   []byte `protobuf:"bytes,13,opt,name=unit_name_b64,json=unitNameB64,proto3" json:"unit_name_b64,omitempty"`
+	Url           string `protobuf:"bytes,14,opt,name=url,proto3" json:"url,omitempty"`
+	UrlB64        []byte `protobuf:"bytes,15,opt,name=url_b64,json=urlB64,proto3" json:"url_b64,omitempty"`
+}
+
+func (x *AssetParams) Reset() {
+	*x = AssetParams{}
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetParams) ProtoMessage() {}
+
+func (x *AssetParams) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetParams.ProtoReflect.Descriptor instead.
+func (*AssetParams) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AssetParams) GetClawback() string {
+	if x != nil {
+		return x.Clawback
+	}
+	return ""
+}
+
+func (x *AssetParams) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *AssetParams) GetDecimals() uint64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *AssetParams) GetDefaultFrozen() bool {
+	if x != nil {
+		return x.DefaultFrozen
+	}
+	return false
+}
+
+func (x *AssetParams) GetFreeze() string {
+	if x != nil {
+		return x.Freeze
+	}
+	return ""
+}
+
+func (x *AssetParams) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
+func (x *AssetParams) GetMetadataHash() []byte {
+	if x != nil {
+		return x.MetadataHash
+	}
+	return nil
+}
+
+func (x *AssetParams) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetParams) GetNameB64() []byte {
+	if x != nil {
+		return x.NameB64
+	}
+	return nil
+}
+
+func (x *AssetParams) GetReserve() string {
+	if x != nil {
+		return x.Reserve
+	}
+	return ""
+}
+
+func (x *AssetParams) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AssetParams) GetUnitName() string {
+	if x != nil {
+		return x.UnitName
+	}
+	return ""
+}
+
+func (x *AssetParams) GetUnitNameB64() []byte {
+	if x != nil {
+		return x.UnitNameB64
+	}
+	return nil
+}
+
+func (x *AssetParams) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AssetParams) GetUrlB64() []byte {
+	if x != nil {
+		return x.UrlB64
+	}
+	return nil
+}
+
+type TransactionAssetTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount      uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetId     uint64 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	CloseAmount uint64 `protobuf:"varint,3,opt,name=close_amount,json=closeAmount,proto3" json:"close_amount,omitempty"`
+	CloseTo     string `protobuf:"bytes,4,opt,name=close_to,json=closeTo,proto3" json:"close_to,omitempty"`
+	Receiver    string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Sender      string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *TransactionAssetTransfer) Reset() {
+	*x = TransactionAssetTransfer{}
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionAssetTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionAssetTransfer) ProtoMessage() {}
+
+func (x *TransactionAssetTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionAssetTransfer.ProtoReflect.Descriptor instead.
+func (*TransactionAssetTransfer) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransactionAssetTransfer) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionAssetTransfer) GetAssetId() uint64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *TransactionAssetTransfer) GetCloseAmount() uint64 {
+	if x != nil {
+		return x.CloseAmount
+	}
+	return 0
+}
+
+func (x *TransactionAssetTransfer) GetCloseTo() string {
+	if x != nil {
+		return x.CloseTo
+	}
+	return ""
+}
+
+func (x *TransactionAssetTransfer) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *TransactionAssetTransfer) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+type TransactionAssetFreeze struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AssetId         uint64 `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	NewFreezeStatus bool   `protobuf:"varint,3,opt,name=new_freeze_status,json=newFreezeStatus,proto3" json:"new_freeze_status,omitempty"`
+}
+
+func (x *TransactionAssetFreeze) Reset() {
+	*x = TransactionAssetFreeze{}
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionAssetFreeze) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionAssetFreeze) ProtoMessage() {}
+
+func (x *TransactionAssetFreeze) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionAssetFreeze.ProtoReflect.Descriptor instead.
+func (*TransactionAssetFreeze) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransactionAssetFreeze) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TransactionAssetFreeze) GetAssetId() uint64 {
+	if x != nil {
+		return x.AssetId
+	}
+	return 0
+}
+
+func (x *TransactionAssetFreeze) GetNewFreezeStatus() bool {
+	if x != nil {
+		return x.NewFreezeStatus
+	}
+	return false
+}
+
+type TransactionApplication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts          []string     `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	ApplicationArgs   [][]byte     `protobuf:"bytes,2,rep,name=application_args,json=applicationArgs,proto3" json:"application_args,omitempty"`
+	ApplicationId     uint64       `protobuf:"varint,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	ApprovalProgram   []byte       `protobuf:"bytes,4,opt,name=approval_program,json=approvalProgram,proto3" json:"approval_program,omitempty"`
+	ClearStateProgram []byte       `protobuf:"bytes,5,opt,name=clear_state_program,json=clearStateProgram,proto3" json:"clear_state_program,omitempty"`
+	ExtraProgramPages uint64       `protobuf:"varint,6,opt,name=extra_program_pages,json=extraProgramPages,proto3" json:"extra_program_pages,omitempty"`
+	ForeignApps       []uint64     `protobuf:"varint,7,rep,packed,name=foreign_apps,json=foreignApps,proto3" json:"foreign_apps,omitempty"`
+	ForeignAssets     []uint64     `protobuf:"varint,8,rep,packed,name=foreign_assets,json=foreignAssets,proto3" json:"foreign_assets,omitempty"`
+	GlobalStateSchema *StateSchema `protobuf:"bytes,9,opt,name=global_state_schema,json=globalStateSchema,proto3" json:"global_state_schema,omitempty"`
+	LocalStateSchema  *StateSchema `protobuf:"bytes,10,opt,name=local_state_schema,json=localStateSchema,proto3" json:"local_state_schema,omitempty"`
+	OnCompletion      string       `protobuf:"bytes,11,opt,name=on_completion,json=onCompletion,proto3" json:"on_completion,omitempty"`
+}
+
+func (x *TransactionApplication) Reset() {
+	*x = TransactionApplication{}
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionApplication) ProtoMessage() {}
+
+func (x *TransactionApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionApplication.ProtoReflect.Descriptor instead.
+func (*TransactionApplication) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransactionApplication) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetApplicationArgs() [][]byte {
+	if x != nil {
+		return x.ApplicationArgs
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *TransactionApplication) GetApprovalProgram() []byte {
+	if x != nil {
+		return x.ApprovalProgram
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetClearStateProgram() []byte {
+	if x != nil {
+		return x.ClearStateProgram
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetExtraProgramPages() uint64 {
+	if x != nil {
+		return x.ExtraProgramPages
+	}
+	return 0
+}
+
+func (x *TransactionApplication) GetForeignApps() []uint64 {
+	if x != nil {
+		return x.ForeignApps
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetForeignAssets() []uint64 {
+	if x != nil {
+		return x.ForeignAssets
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetGlobalStateSchema() *StateSchema {
+	if x != nil {
+		return x.GlobalStateSchema
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetLocalStateSchema() *StateSchema {
+	if x != nil {
+		return x.LocalStateSchema
+	}
+	return nil
+}
+
+func (x *TransactionApplication) GetOnCompletion() string {
+	if x != nil {
+		return x.OnCompletion
+	}
+	return ""
+}
+
+type StateSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumByteSlice uint64 `protobuf:"varint,1,opt,name=num_byte_slice,json=numByteSlice,proto3" json:"num_byte_slice,omitempty"`
+	NumUint      uint64 `protobuf:"varint,2,opt,name=num_uint,json=numUint,proto3" json:"num_uint,omitempty"`
+}
+
+func (x *StateSchema) Reset() {
+	*x = StateSchema{}
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateSchema) ProtoMessage() {}
+
+func (x *StateSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateSchema.ProtoReflect.Descriptor instead.
+func (*StateSchema) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StateSchema) GetNumByteSlice() uint64 {
+	if x != nil {
+		return x.NumByteSlice
+	}
+	return 0
+}
+
+func (x *StateSchema) GetNumUint() uint64 {
+	if x != nil {
+		return x.NumUint
+	}
+	return 0
+}
+
+type TransactionStateProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message        *IndexerStateProofMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	StateProof     *StateProofFields         `protobuf:"bytes,2,opt,name=state_proof,json=stateProof,proto3" json:"state_proof,omitempty"`
+	StateProofType uint64                    `protobuf:"varint,3,opt,name=state_proof_type,json=stateProofType,proto3" json:"state_proof_type,omitempty"`
+}
+
+func (x *TransactionStateProof) Reset() {
+	*x = TransactionStateProof{}
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStateProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStateProof) ProtoMessage() {}
+
+func (x *TransactionStateProof) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStateProof.ProtoReflect.Descriptor instead.
+func (*TransactionStateProof) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransactionStateProof) GetMessage() *IndexerStateProofMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *TransactionStateProof) GetStateProof() *StateProofFields {
+	if x != nil {
+		return x.StateProof
+	}
+	return nil
+}
+
+func (x *TransactionStateProof) GetStateProofType() uint64 {
+	if x != nil {
+		return x.StateProofType
+	}
+	return 0
+}
+
+type IndexerStateProofMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeadersCommitment []byte `protobuf:"bytes,1,opt,name=block_headers_commitment,json=blockHeadersCommitment,proto3" json:"block_headers_commitment,omitempty"`
+	FirstAttestedRound     uint64 `protobuf:"varint,2,opt,name=first_attested_round,json=firstAttestedRound,proto3" json:"first_attested_round,omitempty"`
+	LatestAttestedRound    uint64 `protobuf:"varint,3,opt,name=latest_attested_round,json=latestAttestedRound,proto3" json:"latest_attested_round,omitempty"`
+	LnProvenWeight         uint64 `protobuf:"varint,4,opt,name=ln_proven_weight,json=lnProvenWeight,proto3" json:"ln_proven_weight,omitempty"`
+	VotersCommitment       []byte `protobuf:"bytes,5,opt,name=voters_commitment,json=votersCommitment,proto3" json:"voters_commitment,omitempty"`
+}
+
+func (x *IndexerStateProofMessage) Reset() {
+	*x = IndexerStateProofMessage{}
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexerStateProofMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexerStateProofMessage) ProtoMessage() {}
+
+func (x *IndexerStateProofMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexerStateProofMessage.ProtoReflect.Descriptor instead.
+func (*IndexerStateProofMessage) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IndexerStateProofMessage) GetBlockHeadersCommitment() []byte {
+	if x != nil {
+		return x.BlockHeadersCommitment
+	}
+	return nil
+}
+
+func (x *IndexerStateProofMessage) GetFirstAttestedRound() uint64 {
+	if x != nil {
+		return x.FirstAttestedRound
+	}
+	return 0
+}
+
+func (x *IndexerStateProofMessage) GetLatestAttestedRound() uint64 {
+	if x != nil {
+		return x.LatestAttestedRound
+	}
+	return 0
+}
+
+func (x *IndexerStateProofMessage) GetLnProvenWeight() uint64 {
+	if x != nil {
+		return x.LnProvenWeight
+	}
+	return 0
+}
+
+func (x *IndexerStateProofMessage) GetVotersCommitment() []byte {
+	if x != nil {
+		return x.VotersCommitment
+	}
+	return nil
+}
+
+type StateProofFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartProofs        *MerkleArrayProof   `protobuf:"bytes,1,opt,name=part_proofs,json=partProofs,proto3" json:"part_proofs,omitempty"`
+	PositionsToReveal []uint64            `protobuf:"varint,2,rep,packed,name=positions_to_reveal,json=positionsToReveal,proto3" json:"positions_to_reveal,omitempty"`
+	Reveals           []*StateProofReveal `protobuf:"bytes,3,rep,name=reveals,proto3" json:"reveals,omitempty"`
+	SaltVersion       uint64              `protobuf:"varint,4,opt,name=salt_version,json=saltVersion,proto3" json:"salt_version,omitempty"`
+	SigCommit         []byte              `protobuf:"bytes,5,opt,name=sig_commit,json=sigCommit,proto3" json:"sig_commit,omitempty"`
+	SigProofs         *MerkleArrayProof   `protobuf:"bytes,6,opt,name=sig_proofs,json=sigProofs,proto3" json:"sig_proofs,omitempty"`
+	SignedWeight      uint64              `protobuf:"varint,7,opt,name=signed_weight,json=signedWeight,proto3" json:"signed_weight,omitempty"`
+}
+
+func (x *StateProofFields) Reset() {
+	*x = StateProofFields{}
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProofFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProofFields) ProtoMessage() {}
+
+func (x *StateProofFields) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProofFields.ProtoReflect.Descriptor instead.
+func (*StateProofFields) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StateProofFields) GetPartProofs() *MerkleArrayProof {
+	if x != nil {
+		return x.PartProofs
+	}
+	return nil
+}
+
+func (x *StateProofFields) GetPositionsToReveal() []uint64 {
+	if x != nil {
+		return x.PositionsToReveal
+	}
+	return nil
+}
+
+func (x *StateProofFields) GetReveals() []*StateProofReveal {
+	if x != nil {
+		return x.Reveals
+	}
+	return nil
+}
+
+func (x *StateProofFields) GetSaltVersion() uint64 {
+	if x != nil {
+		return x.SaltVersion
+	}
+	return 0
+}
+
+func (x *StateProofFields) GetSigCommit() []byte {
+	if x != nil {
+		return x.SigCommit
+	}
+	return nil
+}
+
+func (x *StateProofFields) GetSigProofs() *MerkleArrayProof {
+	if x != nil {
+		return x.SigProofs
+	}
+	return nil
+}
+
+func (x *StateProofFields) GetSignedWeight() uint64 {
+	if x != nil {
+		return x.SignedWeight
+	}
+	return 0
+}
+
+type MerkleArrayProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HashFactory *HashFactory `protobuf:"bytes,1,opt,name=hash_factory,json=hashFactory,proto3" json:"hash_factory,omitempty"`
+	Path        [][]byte     `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	TreeDepth   uint64       `protobuf:"varint,3,opt,name=tree_depth,json=treeDepth,proto3" json:"tree_depth,omitempty"`
+}
+
+func (x *MerkleArrayProof) Reset() {
+	*x = MerkleArrayProof{}
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerkleArrayProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerkleArrayProof) ProtoMessage() {}
+
+func (x *MerkleArrayProof) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerkleArrayProof.ProtoReflect.Descriptor instead.
+func (*MerkleArrayProof) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MerkleArrayProof) GetHashFactory() *HashFactory {
+	if x != nil {
+		return x.HashFactory
+	}
+	return nil
+}
+
+func (x *MerkleArrayProof) GetPath() [][]byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *MerkleArrayProof) GetTreeDepth() uint64 {
+	if x != nil {
+		return x.TreeDepth
+	}
+	return 0
+}
+
+type HashFactory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HashType uint64 `protobuf:"varint,1,opt,name=hash_type,json=hashType,proto3" json:"hash_type,omitempty"`
+}
+
+func (x *HashFactory) Reset() {
+	*x = HashFactory{}
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashFactory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashFactory) ProtoMessage() {}
+
+func (x *HashFactory) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashFactory.ProtoReflect.Descriptor instead.
+func (*HashFactory) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HashFactory) GetHashType() uint64 {
+	if x != nil {
+		return x.HashType
+	}
+	return 0
+}
+
+type StateProofReveal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Participant *StateProofParticipant `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	Position    uint64                 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	SigSlot     *StateProofSigSlot     `protobuf:"bytes,3,opt,name=sig_slot,json=sigSlot,proto3" json:"sig_slot,omitempty"`
+}
+
+func (x *StateProofReveal) Reset() {
+	*x = StateProofReveal{}
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProofReveal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProofReveal) ProtoMessage() {}
+
+func (x *StateProofReveal) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProofReveal.ProtoReflect.Descriptor instead.
+func (*StateProofReveal) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StateProofReveal) GetParticipant() *StateProofParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *StateProofReveal) GetPosition() uint64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *StateProofReveal) GetSigSlot() *StateProofSigSlot {
+	if x != nil {
+		return x.SigSlot
+	}
+	return nil
+}
+
+type StateProofParticipant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verifier *StateProofVerifier `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Weight   uint64              `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *StateProofParticipant) Reset() {
+	*x = StateProofParticipant{}
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProofParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProofParticipant) ProtoMessage() {}
+
+func (x *StateProofParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProofParticipant.ProtoReflect.Descriptor instead.
+func (*StateProofParticipant) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StateProofParticipant) GetVerifier() *StateProofVerifier {
+	if x != nil {
+		return x.Verifier
+	}
+	return nil
+}
+
+func (x *StateProofParticipant) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type StateProofVerifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitment  []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	KeyLifetime uint64 `protobuf:"varint,2,opt,name=key_lifetime,json=keyLifetime,proto3" json:"key_lifetime,omitempty"`
+}
+
+func (x *StateProofVerifier) Reset() {
+	*x = StateProofVerifier{}
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProofVerifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProofVerifier) ProtoMessage() {}
+
+func (x *StateProofVerifier) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProofVerifier.ProtoReflect.Descriptor instead.
+func (*StateProofVerifier) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StateProofVerifier) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *StateProofVerifier) GetKeyLifetime() uint64 {
+	if x != nil {
+		return x.KeyLifetime
+	}
+	return 0
+}
+
+type StateProofSigSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LowerSigWeight uint64               `protobuf:"varint,1,opt,name=lower_sig_weight,json=lowerSigWeight,proto3" json:"lower_sig_weight,omitempty"`
+	Signature      *StateProofSignature `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *StateProofSigSlot) Reset() {
+	*x = StateProofSigSlot{}
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProofSigSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProofSigSlot) ProtoMessage() {}
+
+func (x *StateProofSigSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProofSigSlot.ProtoReflect.Descriptor instead.
+func (*StateProofSigSlot) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StateProofSigSlot) GetLowerSigWeight() uint64 {
+	if x != nil {
+		return x.LowerSigWeight
+	}
+	return 0
+}
+
+func (x *StateProofSigSlot) GetSignature() *StateProofSignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type StateProofSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FalconSignature  []byte            `protobuf:"bytes,1,opt,name=falcon_signature,json=falconSignature,proto3" json:"falcon_signature,omitempty"`
+	MerkleArrayIndex uint64            `protobuf:"varint,2,opt,name=merkle_array_index,json=merkleArrayIndex,proto3" json:"merkle_array_index,omitempty"`
+	Proof            *MerkleArrayProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	VerifyingKey     []byte            `protobuf:"bytes,4,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
+}
+
+func (x *StateProofSignature) Reset() {
+	*x = StateProofSignature{}
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProofSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProofSignature) ProtoMessage() {}
+
+func (x *StateProofSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProofSignature.ProtoReflect.Descriptor instead.
+func (*StateProofSignature) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StateProofSignature) GetFalconSignature() []byte {
+	if x != nil {
+		return x.FalconSignature
+	}
+	return nil
+}
+
+func (x *StateProofSignature) GetMerkleArrayIndex() uint64 {
+	if x != nil {
+		return x.MerkleArrayIndex
+	}
+	return 0
+}
+
+func (x *StateProofSignature) GetProof() *MerkleArrayProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *StateProofSignature) GetVerifyingKey() []byte {
+	if x != nil {
+		return x.VerifyingKey
+	}
+	return nil
+}
+
+type TransactionSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logicsig *TransactionSignatureLogicsig `protobuf:"bytes,1,opt,name=logicsig,proto3" json:"logicsig,omitempty"`
+	Multisig *TransactionSignatureMultisig `protobuf:"bytes,2,opt,name=multisig,proto3" json:"multisig,omitempty"`
+	Sig      []byte                        `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *TransactionSignature) Reset() {
+	*x = TransactionSignature{}
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSignature) ProtoMessage() {}
+
+func (x *TransactionSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSignature.ProtoReflect.Descriptor instead.
+func (*TransactionSignature) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TransactionSignature) GetLogicsig() *TransactionSignatureLogicsig {
+	if x != nil {
+		return x.Logicsig
+	}
+	return nil
+}
+
+func (x *TransactionSignature) GetMultisig() *TransactionSignatureMultisig {
+	if x != nil {
+		return x.Multisig
+	}
+	return nil
+}
+
+func (x *TransactionSignature) GetSig() []byte {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+type TransactionSignatureLogicsig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Args              [][]byte                      `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	Logic             []byte                        `protobuf:"bytes,2,opt,name=logic,proto3" json:"logic,omitempty"`
+	MultisigSignature *TransactionSignatureMultisig `protobuf:"bytes,3,opt,name=multisig_signature,json=multisigSignature,proto3" json:"multisig_signature,omitempty"`
+	Signature         []byte                        `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *TransactionSignatureLogicsig) Reset() {
+	*x = TransactionSignatureLogicsig{}
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSignatureLogicsig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSignatureLogicsig) ProtoMessage() {}
+
+func (x *TransactionSignatureLogicsig) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSignatureLogicsig.ProtoReflect.Descriptor instead.
+func (*TransactionSignatureLogicsig) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TransactionSignatureLogicsig) GetArgs() [][]byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *TransactionSignatureLogicsig) GetLogic() []byte {
+	if x != nil {
+		return x.Logic
+	}
+	return nil
+}
+
+func (x *TransactionSignatureLogicsig) GetMultisigSignature() *TransactionSignatureMultisig {
+	if x != nil {
+		return x.MultisigSignature
+	}
+	return nil
+}
+
+func (x *TransactionSignatureLogicsig) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type TransactionSignatureMultisig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subsignature []*TransactionSignatureMultisigSubsignature `protobuf:"bytes,1,rep,name=subsignature,proto3" json:"subsignature,omitempty"`
+	Threshold    uint64                                      `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Version      uint64                                      `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *TransactionSignatureMultisig) Reset() {
+	*x = TransactionSignatureMultisig{}
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSignatureMultisig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSignatureMultisig) ProtoMessage() {}
+
+func (x *TransactionSignatureMultisig) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSignatureMultisig.ProtoReflect.Descriptor instead.
+func (*TransactionSignatureMultisig) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TransactionSignatureMultisig) GetSubsignature() []*TransactionSignatureMultisigSubsignature {
+	if x != nil {
+		return x.Subsignature
+	}
+	return nil
+}
+
+func (x *TransactionSignatureMultisig) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *TransactionSignatureMultisig) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type TransactionSignatureMultisigSubsignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *TransactionSignatureMultisigSubsignature) Reset() {
+	*x = TransactionSignatureMultisigSubsignature{}
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSignatureMultisigSubsignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSignatureMultisigSubsignature) ProtoMessage() {}
+
+func (x *TransactionSignatureMultisigSubsignature) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSignatureMultisigSubsignature.ProtoReflect.Descriptor instead.
+func (*TransactionSignatureMultisigSubsignature) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TransactionSignatureMultisigSubsignature) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *TransactionSignatureMultisigSubsignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type EvalDeltaKeyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *EvalDelta `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EvalDeltaKeyValue) Reset() {
+	*x = EvalDeltaKeyValue{}
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvalDeltaKeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvalDeltaKeyValue) ProtoMessage() {}
+
+func (x *EvalDeltaKeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvalDeltaKeyValue.ProtoReflect.Descriptor instead.
+func (*EvalDeltaKeyValue) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EvalDeltaKeyValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EvalDeltaKeyValue) GetValue() *EvalDelta {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type EvalDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action uint64 `protobuf:"varint,1,opt,name=action,proto3" json:"action,omitempty"`
+	Bytes  string `protobuf:"bytes,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Uint   uint64 `protobuf:"varint,3,opt,name=uint,proto3" json:"uint,omitempty"`
+}
+
+func (x *EvalDelta) Reset() {
+	*x = EvalDelta{}
+	mi := &file_notification_v1_notification_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvalDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvalDelta) ProtoMessage() {}
+
+func (x *EvalDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvalDelta.ProtoReflect.Descriptor instead.
+func (*EvalDelta) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EvalDelta) GetAction() uint64 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *EvalDelta) GetBytes() string {
+	if x != nil {
+		return x.Bytes
+	}
+	return ""
+}
+
+func (x *EvalDelta) GetUint() uint64 {
+	if x != nil {
+		return x.Uint
+	}
+	return 0
+}
+
+type AccountStateDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Delta   []*EvalDeltaKeyValue `protobuf:"bytes,2,rep,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *AccountStateDelta) Reset() {
+	*x = AccountStateDelta{}
+	mi := &file_notification_v1_notification_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStateDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStateDelta) ProtoMessage() {}
+
+func (x *AccountStateDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStateDelta.ProtoReflect.Descriptor instead.
+func (*AccountStateDelta) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AccountStateDelta) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AccountStateDelta) GetDelta() []*EvalDeltaKeyValue {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+var File_notification_v1_notification_proto protoreflect.FileDescriptor
+
+var file_notification_v1_notification_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76,
+	0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0x76, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xc8, 0x01,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2e,
+	0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x40,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x86, 0x06, 0x0a, 0x05, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x49, 0x64, 0x12, 0x5a, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x14, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x73, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x78, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x78, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x12, 0x47, 0x0a, 0x0d, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x0b, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x65, 0x53, 0x69, 0x6e, 0x6b, 0x12, 0x3a, 0x0a,
+	0x19, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f,
+	0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x69, 0x64, 0x75, 0x65, 0x22, 0x8d, 0x02,
+	0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x5f, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x4f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x89, 0x01,
+	0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x14, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x1e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1c, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xac,
+	0x0d, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60,
+	0x0a, 0x17, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x61, 0x0a, 0x18, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x16, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x18, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x16,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x1a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x18, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x69,
+	0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x49, 0x64, 0x12, 0x50, 0x0a, 0x12, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x54, 0x78, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x72, 0x61,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x6e, 0x74, 0x72, 0x61, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x6b, 0x65, 0x79, 0x72, 0x65, 0x67, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x72, 0x65, 0x67, 0x52, 0x11, 0x6b, 0x65, 0x79, 0x72, 0x65, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x0f, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x5f,
+	0x74, 0x6f, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x54,
+	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x43, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x5e, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x15, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x22, 0x99, 0x01,
+	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0xdc, 0x02, 0x0a, 0x11, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x72, 0x65, 0x67, 0x12,
+	0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x1b,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x19, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x76, 0x6f, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x69, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x44, 0x69, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x14, 0x76, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x69, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0xa8, 0x03, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x77, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x62, 0x36, 0x34, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x36, 0x34, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x62, 0x36, 0x34,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x72, 0x6c, 0x5f, 0x62, 0x36, 0x34,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x72, 0x6c, 0x42, 0x36, 0x34, 0x22, 0xbf,
+	0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x22, 0x79, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9a, 0x04, 0x0a, 0x16,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12,
+	0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x6c,
+	0x65, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12,
+	0x2e, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x41, 0x70,
+	0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x13, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x11, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x4a, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6e, 0x75, 0x6d, 0x55, 0x69, 0x6e, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x43, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x54, 0x79, 0x70, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x18, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x6e,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xec, 0x02, 0x0a, 0x10, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x42,
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x11, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x6c, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x41, 0x72,
+	0x72, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x09, 0x73, 0x69, 0x67, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3f, 0x0a,
+	0x0c, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x72, 0x65, 0x65, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x22, 0x2a, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb7, 0x01,
+	0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x12, 0x48, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x69, 0x67, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x07,
+	0x73, 0x69, 0x67, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x70, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x12, 0x3f, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x57, 0x0a, 0x12, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x53, 0x69, 0x67, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x73, 0x69, 0x67, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x69, 0x67, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x66, 0x61, 0x6c, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x66, 0x61, 0x6c, 0x63, 0x6f, 0x6e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x49,
+	0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x52,
+	0x08, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x08, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xc4, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c,
+	0x6f, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x12, 0x5c, 0x0a, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x11, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb5, 0x01,
+	0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x12, 0x5d,
+	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x73, 0x69, 0x67, 0x53, 0x75, 0x62, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x28, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x75, 0x62, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x57,
+	0x0a, 0x11, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x45, 0x76, 0x61, 0x6c, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x32,
+	0x5f, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x79, 0x6e, 0x79, 0x63, 0x62, 0x6f, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x61, 0x6e,
+	0x64, 0x2d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_notification_v1_notification_proto_rawDescOnce sync.Once
+	file_notification_v1_notification_proto_rawDescData = file_notification_v1_notification_proto_rawDesc
+)
+
+func file_notification_v1_notification_proto_rawDescGZIP() []byte {
+	file_notification_v1_notification_proto_rawDescOnce.Do(func() {
+		file_notification_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(file_notification_v1_notification_proto_rawDescData)
+	})
+	return file_notification_v1_notification_proto_rawDescData
+}
+
+var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_notification_v1_notification_proto_goTypes = []any{
+	(*SubscribeRequest)(nil),                         // 0: notification.v1.SubscribeRequest
+	(*Event)(nil),                                    // 1: notification.v1.Event
+	(*Block)(nil),                                    // 2: notification.v1.Block
+	(*BlockRewards)(nil),                             // 3: notification.v1.BlockRewards
+	(*BlockUpgradeState)(nil),                        // 4: notification.v1.BlockUpgradeState
+	(*BlockUpgradeVote)(nil),                         // 5: notification.v1.BlockUpgradeVote
+	(*ParticipationUpdates)(nil),                     // 6: notification.v1.ParticipationUpdates
+	(*StateProofTracking)(nil),                       // 7: notification.v1.StateProofTracking
+	(*Transaction)(nil),                              // 8: notification.v1.Transaction
+	(*TransactionPayment)(nil),                       // 9: notification.v1.TransactionPayment
+	(*TransactionKeyreg)(nil),                        // 10: notification.v1.TransactionKeyreg
+	(*TransactionAssetConfig)(nil),                   // 11: notification.v1.TransactionAssetConfig
+	(*AssetParams)(nil),                              // 12: notification.v1.AssetParams
+	(*TransactionAssetTransfer)(nil),                 // 13: notification.v1.TransactionAssetTransfer
+	(*TransactionAssetFreeze)(nil),                   // 14: notification.v1.TransactionAssetFreeze
+	(*TransactionApplication)(nil),                   // 15: notification.v1.TransactionApplication
+	(*StateSchema)(nil),                              // 16: notification.v1.StateSchema
+	(*TransactionStateProof)(nil),                    // 17: notification.v1.TransactionStateProof
+	(*IndexerStateProofMessage)(nil),                 // 18: notification.v1.IndexerStateProofMessage
+	(*StateProofFields)(nil),                         // 19: notification.v1.StateProofFields
+	(*MerkleArrayProof)(nil),                         // 20: notification.v1.MerkleArrayProof
+	(*HashFactory)(nil),                              // 21: notification.v1.HashFactory
+	(*StateProofReveal)(nil),                         // 22: notification.v1.StateProofReveal
+	(*StateProofParticipant)(nil),                    // 23: notification.v1.StateProofParticipant
+	(*StateProofVerifier)(nil),                       // 24: notification.v1.StateProofVerifier
+	(*StateProofSigSlot)(nil),                        // 25: notification.v1.StateProofSigSlot
+	(*StateProofSignature)(nil),                      // 26: notification.v1.StateProofSignature
+	(*TransactionSignature)(nil),                     // 27: notification.v1.TransactionSignature
+	(*TransactionSignatureLogicsig)(nil),             // 28: notification.v1.TransactionSignatureLogicsig
+	(*TransactionSignatureMultisig)(nil),             // 29: notification.v1.TransactionSignatureMultisig
+	(*TransactionSignatureMultisigSubsignature)(nil), // 30: notification.v1.TransactionSignatureMultisigSubsignature
+	(*EvalDeltaKeyValue)(nil),                        // 31: notification.v1.EvalDeltaKeyValue
+	(*EvalDelta)(nil),                                // 32: notification.v1.EvalDelta
+	(*AccountStateDelta)(nil),                        // 33: notification.v1.AccountStateDelta
+}
+var file_notification_v1_notification_proto_depIdxs = []int32{
+	2,  // 0: notification.v1.Event.block:type_name -> notification.v1.Block
+	8,  // 1: notification.v1.Event.transaction:type_name -> notification.v1.Transaction
+	6,  // 2: notification.v1.Block.participation_updates:type_name -> notification.v1.ParticipationUpdates
+	3,  // 3: notification.v1.Block.rewards:type_name -> notification.v1.BlockRewards
+	7,  // 4: notification.v1.Block.state_proof_tracking:type_name -> notification.v1.StateProofTracking
+	8,  // 5: notification.v1.Block.transactions:type_name -> notification.v1.Transaction
+	4,  // 6: notification.v1.Block.upgrade_state:type_name -> notification.v1.BlockUpgradeState
+	5,  // 7: notification.v1.Block.upgrade_vote:type_name -> notification.v1.BlockUpgradeVote
+	15, // 8: notification.v1.Transaction.application_transaction:type_name -> notification.v1.TransactionApplication
+	11, // 9: notification.v1.Transaction.asset_config_transaction:type_name -> notification.v1.TransactionAssetConfig
+	14, // 10: notification.v1.Transaction.asset_freeze_transaction:type_name -> notification.v1.TransactionAssetFreeze
+	13, // 11: notification.v1.Transaction.asset_transfer_transaction:type_name -> notification.v1.TransactionAssetTransfer
+	31, // 12: notification.v1.Transaction.global_state_delta:type_name -> notification.v1.EvalDeltaKeyValue
+	8,  // 13: notification.v1.Transaction.inner_txns:type_name -> notification.v1.Transaction
+	10, // 14: notification.v1.Transaction.keyreg_transaction:type_name -> notification.v1.TransactionKeyreg
+	33, // 15: notification.v1.Transaction.local_state_delta:type_name -> notification.v1.AccountStateDelta
+	9,  // 16: notification.v1.Transaction.payment_transaction:type_name -> notification.v1.TransactionPayment
+	27, // 17: notification.v1.Transaction.signature:type_name -> notification.v1.TransactionSignature
+	17, // 18: notification.v1.Transaction.state_proof_transaction:type_name -> notification.v1.TransactionStateProof
+	12, // 19: notification.v1.TransactionAssetConfig.params:type_name -> notification.v1.AssetParams
+	16, // 20: notification.v1.TransactionApplication.global_state_schema:type_name -> notification.v1.StateSchema
+	16, // 21: notification.v1.TransactionApplication.local_state_schema:type_name -> notification.v1.StateSchema
+	18, // 22: notification.v1.TransactionStateProof.message:type_name -> notification.v1.IndexerStateProofMessage
+	19, // 23: notification.v1.TransactionStateProof.state_proof:type_name -> notification.v1.StateProofFields
+	20, // 24: notification.v1.StateProofFields.part_proofs:type_name -> notification.v1.MerkleArrayProof
+	22, // 25: notification.v1.StateProofFields.reveals:type_name -> notification.v1.StateProofReveal
+	20, // 26: notification.v1.StateProofFields.sig_proofs:type_name -> notification.v1.MerkleArrayProof
+	21, // 27: notification.v1.MerkleArrayProof.hash_factory:type_name -> notification.v1.HashFactory
+	23, // 28: notification.v1.StateProofReveal.participant:type_name -> notification.v1.StateProofParticipant
+	25, // 29: notification.v1.StateProofReveal.sig_slot:type_name -> notification.v1.StateProofSigSlot
+	24, // 30: notification.v1.StateProofParticipant.verifier:type_name -> notification.v1.StateProofVerifier
+	26, // 31: notification.v1.StateProofSigSlot.signature:type_name -> notification.v1.StateProofSignature
+	20, // 32: notification.v1.StateProofSignature.proof:type_name -> notification.v1.MerkleArrayProof
+	28, // 33: notification.v1.TransactionSignature.logicsig:type_name -> notification.v1.TransactionSignatureLogicsig
+	29, // 34: notification.v1.TransactionSignature.multisig:type_name -> notification.v1.TransactionSignatureMultisig
+	29, // 35: notification.v1.TransactionSignatureLogicsig.multisig_signature:type_name -> notification.v1.TransactionSignatureMultisig
+	30, // 36: notification.v1.TransactionSignatureMultisig.subsignature:type_name -> notification.v1.TransactionSignatureMultisigSubsignature
+	32, // 37: notification.v1.EvalDeltaKeyValue.value:type_name -> notification.v1.EvalDelta
+	31, // 38: notification.v1.AccountStateDelta.delta:type_name -> notification.v1.EvalDeltaKeyValue
+	0,  // 39: notification.v1.NotificationService.Subscribe:input_type -> notification.v1.SubscribeRequest
+	1,  // 40: notification.v1.NotificationService.Subscribe:output_type -> notification.v1.Event
+	40, // [40:41] is the sub-list for method output_type
+	39, // [39:40] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_notification_proto_init() }
+func file_notification_v1_notification_proto_init() {
+	if File_notification_v1_notification_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_msgTypes[1].OneofWrappers = []any{
+		(*Event_Block)(nil),
+		(*Event_Transaction)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_notification_v1_notification_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   34,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_notification_proto_goTypes,
+		DependencyIndexes: file_notification_v1_notification_proto_depIdxs,
+		MessageInfos:      file_notification_v1_notification_proto_msgTypes,
+	}.Build()
+	File_notification_v1_notification_proto = out.File
+	file_notification_v1_notification_proto_rawDesc = nil
+	file_notification_v1_notification_proto_goTypes = nil
+	file_notification_v1_notification_proto_depIdxs = nil
+}
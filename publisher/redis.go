@@ -2,23 +2,41 @@ package publisher
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	// ErrFenced is returned when a fenced publisher is no longer the leader
+	ErrFenced = errors.New("publisher: fencing token is stale")
+
+	// fencedPublishScript publishes only if the fencing token is still the latest one
+	fencedPublishScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PUBLISH", KEYS[2], ARGV[2])
+end
+return -1
+`)
+)
+
 // RedisConfig represents a configuration for Redis publisher
 type RedisConfig struct {
 	RedisHost     string
 	RedisPassword string
 	Channel       string
+	// FenceKey holds the latest fencing token, it is only used by a publisher returned by Fenced
+	FenceKey string
 }
 
 // RedisPublisher handles event publishing
 type RedisPublisher struct {
-	conf RedisConfig
-	rdb  *redis.Client
+	conf  RedisConfig
+	rdb   *redis.Client
+	token uint64
 }
 
 // NewRedis creates a new Redis publisher
@@ -28,14 +46,14 @@ func NewRedis(conf RedisConfig) (*RedisPublisher, error) {
 		Password: conf.RedisPassword,
 	})
 
-  ctx, cancel := context.WithTimeout(context.Background(), time.Duration(10) * time.Second)
-  defer cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(10)*time.Second)
+	defer cancel()
 
-  if err := rdb.Ping(ctx).Err(); err != nil {
-    return nil, err
-  }
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
 
-  log.Info().Msg("publisher: connected to Redis")
+	log.Info().Msg("publisher: connected to Redis")
 
 	return &RedisPublisher{
 		conf: conf,
@@ -43,12 +61,48 @@ func NewRedis(conf RedisConfig) (*RedisPublisher, error) {
 	}, nil
 }
 
+// Ping checks that Redis is reachable
+func (p *RedisPublisher) Ping(ctx context.Context) error {
+	return p.rdb.Ping(ctx).Err()
+}
+
+// Close closes the Redis connection
+func (p *RedisPublisher) Close() error {
+	return p.rdb.Close()
+}
+
+// Fenced returns a publisher sharing the connection that rejects messages once the token is not the latest one
+func (p *RedisPublisher) Fenced(token uint64) *RedisPublisher {
+	return &RedisPublisher{
+		conf:  p.conf,
+		rdb:   p.rdb,
+		token: token,
+	}
+}
+
 // Publish send an event
 func (p *RedisPublisher) Publish(ctx context.Context, message []byte) error {
-  if err := p.rdb.Publish(ctx, p.conf.Channel, message).Err(); err != nil {
-    return err
-  }
-
-  return nil
+	return p.PublishTo(ctx, p.conf.Channel, message)
 }
 
+// PublishTo sends a message to a channel
+func (p *RedisPublisher) PublishTo(ctx context.Context, channel string, message []byte) error {
+	if p.token > 0 {
+		receivers, err := fencedPublishScript.Run(ctx, p.rdb, []string{p.conf.FenceKey, channel}, strconv.FormatUint(p.token, 10), message).Int()
+		if err != nil {
+			return err
+		}
+
+		if receivers < 0 {
+			return ErrFenced
+		}
+
+		return nil
+	}
+
+	if err := p.rdb.Publish(ctx, channel, message).Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package quota
+
+import (
+	"sync"
+)
+
+const (
+	// ReasonConnectionLimit is a reason of rejecting a connection over the tenant limit
+	ReasonConnectionLimit = "CONNECTION_LIMIT"
+
+	// ReasonSubscriptionLimit is a reason of rejecting a subscription over the connection limit
+	ReasonSubscriptionLimit = "SUBSCRIPTION_LIMIT"
+
+	// ReasonParamsLimit is a reason of rejecting a request with too many params
+	ReasonParamsLimit = "PARAMS_LIMIT"
+
+	// ReasonRateLimit is a reason of rejecting a request over the request rate
+	ReasonRateLimit = "RATE_LIMIT"
+)
+
+// Error is a quota error with a machine readable reason
+type Error struct {
+	Reason  string
+	Message string
+}
+
+// Error returns an error message
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Config represents a quota configuration, zero values mean unlimited
+type Config struct {
+	MaxConnectionsPerTenant       int
+	MaxSubscriptionsPerConnection int
+	MaxParamsPerRequest           int
+
+	// RequestRate is a number of SUBSCRIBE/UNSUBSCRIBE requests allowed per second for each connection
+	RequestRate float64
+
+	// RequestBurst is a maximum burst of SUBSCRIBE/UNSUBSCRIBE requests for each connection
+	RequestBurst int
+}
+
+// Limiter tracks concurrent connections of tenants
+type Limiter struct {
+	mu          sync.Mutex
+	conf        Config
+	connections map[string]int
+}
+
+// New creates a new limiter
+func New(conf Config) *Limiter {
+	return &Limiter{
+		conf:        conf,
+		connections: make(map[string]int),
+	}
+}
+
+// Config returns the current configuration
+func (l *Limiter) Config() Config {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.conf
+}
+
+// SetConfig replaces the configuration, connections over a lowered limit are kept until they close
+func (l *Limiter) SetConfig(conf Config) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.conf = conf
+}
+
+// Acquire reserves a connection slot of a tenant, release must be called once the connection is closed
+func (l *Limiter) Acquire(tenant string) (release func(), err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conf.MaxConnectionsPerTenant > 0 && l.connections[tenant] >= l.conf.MaxConnectionsPerTenant {
+		return nil, &Error{
+			Reason:  ReasonConnectionLimit,
+			Message: "too many connections",
+		}
+	}
+
+	l.connections[tenant]++
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+
+			l.connections[tenant]--
+			if l.connections[tenant] <= 0 {
+				delete(l.connections, tenant)
+			}
+		})
+	}, nil
+}
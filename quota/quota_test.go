@@ -0,0 +1,104 @@
+package quota
+
+import (
+	"testing"
+)
+
+func TestLimiterAcquire(t *testing.T) {
+	tests := []struct {
+		name     string
+		max      int
+		tenants  []string
+		rejected []bool
+	}{
+		{
+			name:     "unlimited",
+			max:      0,
+			tenants:  []string{"a", "a", "a"},
+			rejected: []bool{false, false, false},
+		},
+		{
+			name:     "limit of a tenant",
+			max:      2,
+			tenants:  []string{"a", "a", "a"},
+			rejected: []bool{false, false, true},
+		},
+		{
+			name:     "tenants are limited separately",
+			max:      1,
+			tenants:  []string{"a", "b", "a", "b", "c"},
+			rejected: []bool{false, false, true, true, false},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			l := New(Config{MaxConnectionsPerTenant: tc.max})
+			for i, tenant := range tc.tenants {
+				release, err := l.Acquire(tenant)
+				if rejected := err != nil; rejected != tc.rejected[i] {
+					t.Fatalf("connection %d of %s: rejected %v, want %v", i, tenant, rejected, tc.rejected[i])
+				}
+
+				if err == nil {
+					continue
+				}
+
+				qErr, ok := err.(*Error)
+				if !ok || qErr.Reason != ReasonConnectionLimit || release != nil {
+					t.Fatalf("connection %d of %s was rejected with %v", i, tenant, err)
+				}
+			}
+		})
+	}
+}
+
+func TestLimiterRelease(t *testing.T) {
+	l := New(Config{MaxConnectionsPerTenant: 1})
+
+	release, err := l.Acquire("a")
+	if err != nil {
+		t.Fatalf("failed to acquire: %v", err)
+	}
+
+	// releasing twice frees a single slot
+	release()
+	release()
+
+	if _, err := l.Acquire("a"); err != nil {
+		t.Fatalf("a released slot was not reused: %v", err)
+	}
+
+	if _, err := l.Acquire("a"); err == nil {
+		t.Fatal("a slot was released twice")
+	}
+}
+
+func TestLimiterSetConfig(t *testing.T) {
+	l := New(Config{MaxConnectionsPerTenant: 3})
+
+	var releases []func()
+	for i := 0; i < 3; i++ {
+		release, err := l.Acquire("a")
+		if err != nil {
+			t.Fatalf("failed to acquire: %v", err)
+		}
+		releases = append(releases, release)
+	}
+
+	// connections over a lowered limit are kept, new ones wait until enough of them are closed
+	l.SetConfig(Config{MaxConnectionsPerTenant: 2})
+	if got := l.Config().MaxConnectionsPerTenant; got != 2 {
+		t.Fatalf("limit is %d, want 2", got)
+	}
+
+	releases[0]()
+	if _, err := l.Acquire("a"); err == nil {
+		t.Fatal("a connection over the lowered limit was accepted")
+	}
+
+	releases[1]()
+	if _, err := l.Acquire("a"); err != nil {
+		t.Fatalf("a connection under the lowered limit was rejected: %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+const (
+	// DefaultLimit is a default number of events returned by a query
+	DefaultLimit = 100
+
+	// MaxLimit is a maximum number of events returned by a query
+	MaxLimit = 1000
+)
+
+var (
+	// ErrInvalidSize is returned when the store size is not positive
+	ErrInvalidSize = errors.New("store: size must be greater than zero")
+
+	// ErrInvalidCursor is returned when a cursor cannot be parsed
+	ErrInvalidCursor = errors.New("store: cursor is invalid")
+)
+
+// MemoryConfig represents a configuration for in-memory store
+type MemoryConfig struct {
+	// Size is a maximum number of recent events kept in the store
+	Size int
+}
+
+// Filter represents a query filter
+type Filter struct {
+	Types     []string
+	Address   string
+	FromRound uint64
+	Cursor    string
+	Limit     int
+}
+
+// Page represents a page of events
+type Page struct {
+	Events     []*event.Event
+	NextCursor string
+}
+
+// MemoryStore keeps recently received events in a ring buffer
+type MemoryStore struct {
+	mu          sync.RWMutex
+	entries     []*event.Event
+	head        int
+	count       int
+	seq         uint64
+	latestRound uint64
+	txs         map[string]*event.Event
+}
+
+// NewMemory creates a new in-memory store
+func NewMemory(conf MemoryConfig) (*MemoryStore, error) {
+	if conf.Size <= 0 {
+		return nil, ErrInvalidSize
+	}
+
+	return &MemoryStore{
+		entries: make([]*event.Event, conf.Size),
+		txs:     make(map[string]*event.Event),
+	}, nil
+}
+
+// Add appends events to the store and assigns a sequence number to each of them,
+// evicting the oldest ones when the store is full. Sequence numbers follow the order events are received,
+// which may differ from the round order, so they are local to this store.
+// The store keeps uncached copies, so encodings of dispatched events are not kept with it.
+func (s *MemoryStore) Add(events []*event.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range events {
+		s.seq++
+		if s.count == len(s.entries) {
+			evicted := s.entries[s.head]
+			if evicted.TxID != "" && s.txs[evicted.TxID] == evicted {
+				delete(s.txs, evicted.TxID)
+			}
+		} else {
+			s.count++
+		}
+
+		e.Seq = s.seq
+		stored := e.Uncached()
+		s.entries[s.head] = stored
+		s.head = (s.head + 1) % len(s.entries)
+		if e.TxID != "" {
+			s.txs[e.TxID] = stored
+		}
+
+		if e.Round > s.latestRound {
+			s.latestRound = e.Round
+		}
+	}
+}
+
+// LatestRound returns the latest round seen by the store
+func (s *MemoryStore) LatestRound() (uint64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.latestRound, s.count > 0
+}
+
+// Tx returns a transaction event by its id
+func (s *MemoryStore) Tx(id string) (*event.Event, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, ok := s.txs[id]
+
+	return e, ok
+}
+
+// Query returns events matched with the filter in the order they were received
+func (s *MemoryStore) Query(f Filter) (*Page, error) {
+	var after uint64
+	if f.Cursor != "" {
+		cursor, err := strconv.ParseUint(f.Cursor, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+
+		after = cursor
+	}
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	page := &Page{
+		Events:     []*event.Event{},
+		NextCursor: f.Cursor,
+	}
+	start := (s.head - s.count + len(s.entries)) % len(s.entries)
+	for i := 0; i < s.count && len(page.Events) < limit; i++ {
+		e := s.entries[(start+i)%len(s.entries)]
+		if e.Seq <= after || !match(e, f) {
+			continue
+		}
+
+		page.Events = append(page.Events, e)
+		page.NextCursor = strconv.FormatUint(e.Seq, 10)
+	}
+
+	return page, nil
+}
+
+func match(e *event.Event, f Filter) bool {
+	if len(f.Types) > 0 && !containsType(f.Types, e.Type) {
+		return false
+	}
+
+	if f.Address != "" && !e.HasAddress(f.Address) {
+		return false
+	}
+
+	return e.Round >= f.FromRound
+}
+
+func containsType(types []string, t string) bool {
+	for _, v := range types {
+		if v == t {
+			return true
+		}
+	}
+
+	return false
+}
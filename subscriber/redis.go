@@ -2,28 +2,34 @@ package subscriber
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/rs/zerolog/log"
 )
 
-// ProcessorFunc represents a processor function that consume message
-type ProcessorFunc func(message []byte)
+// ProcessorFunc represents a processor function that consume message of a channel
+type ProcessorFunc func(channel string, message []byte)
 
 // RedisConfig represents a configuration for Redis subscriber
 type RedisConfig struct {
 	RedisHost     string
 	RedisPassword string
-	Channel       string
-	Processor     ProcessorFunc
+	// Channel is subscribed from the start, more channels can be subscribed with SetChannels
+	Channel   string
+	Processor ProcessorFunc
 }
 
 // RedisSubscriber handles event subscription
 type RedisSubscriber struct {
-	conf   *RedisConfig
-	rdb    *redis.Client
-	pubsub *redis.PubSub
+	conf     *RedisConfig
+	rdb      *redis.Client
+	pubsub   *redis.PubSub
+	doneChan chan struct{}
+
+	mu       sync.Mutex
+	channels map[string]struct{}
 }
 
 // NewRedis creates a new Redis subscriber
@@ -43,8 +49,10 @@ func NewRedis(conf *RedisConfig) (*RedisSubscriber, error) {
 	log.Info().Msg("subscriber: connected to Redis")
 
 	s := &RedisSubscriber{
-		conf: conf,
-		rdb:  rdb,
+		conf:     conf,
+		rdb:      rdb,
+		doneChan: make(chan struct{}),
+		channels: make(map[string]struct{}),
 	}
 	if err := s.subscribe(); err != nil {
 		return nil, err
@@ -53,20 +61,82 @@ func NewRedis(conf *RedisConfig) (*RedisSubscriber, error) {
 	return s, nil
 }
 
-// Close closes a subscription
+// Ping checks that the subscription connection is alive
+func (s *RedisSubscriber) Ping(ctx context.Context) error {
+	return s.pubsub.Ping(ctx)
+}
+
+// Close closes a subscription and waits for the message being processed
 func (s *RedisSubscriber) Close() error {
-	return s.pubsub.Close()
+	err := s.pubsub.Close()
+	<-s.doneChan
+
+	if rErr := s.rdb.Close(); err == nil {
+		err = rErr
+	}
+
+	return err
+}
+
+// SetChannels subscribes to the given channels and unsubscribes from the others
+func (s *RedisSubscriber) SetChannels(ctx context.Context, channels []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := make(map[string]struct{}, len(channels))
+	var added []string
+	for _, channel := range channels {
+		next[channel] = struct{}{}
+		if _, exist := s.channels[channel]; !exist {
+			added = append(added, channel)
+		}
+	}
+
+	var removed []string
+	for channel := range s.channels {
+		if _, exist := next[channel]; !exist {
+			removed = append(removed, channel)
+		}
+	}
+
+	if len(added) > 0 {
+		if err := s.pubsub.Subscribe(ctx, added...); err != nil {
+			return err
+		}
+
+		log.Info().Msgf("subscriber: subscribed to %v", added)
+	}
+
+	if len(removed) > 0 {
+		if err := s.pubsub.Unsubscribe(ctx, removed...); err != nil {
+			return err
+		}
+
+		log.Info().Msgf("subscriber: unsubscribed from %v", removed)
+	}
+
+	s.channels = next
+
+	return nil
 }
 
 func (s *RedisSubscriber) subscribe() error {
-	s.pubsub = s.rdb.Subscribe(context.Background(), s.conf.Channel)
-	if _, err := s.pubsub.Receive(context.Background()); err != nil {
-		return err
+	if s.conf.Channel == "" {
+		s.pubsub = s.rdb.Subscribe(context.Background())
+	} else {
+		s.pubsub = s.rdb.Subscribe(context.Background(), s.conf.Channel)
+		if _, err := s.pubsub.Receive(context.Background()); err != nil {
+			return err
+		}
+
+		s.channels[s.conf.Channel] = struct{}{}
 	}
 
 	go func() {
+		defer close(s.doneChan)
+
 		for m := range s.pubsub.Channel() {
-			s.conf.Processor([]byte(m.Payload))
+			s.conf.Processor(m.Channel, []byte(m.Payload))
 		}
 	}()
 
@@ -0,0 +1,178 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ClientAuthNone does not request client certificates
+	ClientAuthNone = "none"
+
+	// ClientAuthRequest verifies client certificates if they are given
+	ClientAuthRequest = "request"
+
+	// ClientAuthRequire requires verified client certificates (mTLS)
+	ClientAuthRequire = "require"
+)
+
+// Config represents a TLS configuration
+type Config struct {
+	CertFile string
+	KeyFile  string
+
+	// ClientCAFile is a PEM encoded CA bundle for verifying client certificates
+	ClientCAFile string
+
+	// ClientAuth is one of none, request or require
+	ClientAuth string
+}
+
+// Reloader serves certificates and reloads them when the files change
+type Reloader struct {
+	conf       Config
+	clientAuth tls.ClientAuthType
+	mu         sync.RWMutex
+	cert       *tls.Certificate
+	clientCAs  *x509.CertPool
+	watcher    *fsnotify.Watcher
+}
+
+// New creates a new reloader and starts watching the files
+func New(conf Config) (*Reloader, error) {
+	r := &Reloader{
+		conf: conf,
+	}
+
+	switch conf.ClientAuth {
+	case "", ClientAuthNone:
+		r.clientAuth = tls.NoClientCert
+	case ClientAuthRequest:
+		r.clientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		r.clientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("tlsconfig: unsupported client auth %s", conf.ClientAuth)
+	}
+
+	if r.clientAuth != tls.NoClientCert && conf.ClientCAFile == "" {
+		return nil, fmt.Errorf("tlsconfig: ClientCAFile is required for client auth %s", conf.ClientAuth)
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// watch directories rather than files, so that atomic replacements (e.g. Kubernetes secrets) are noticed
+	dirs := make(map[string]struct{})
+	for _, f := range []string{conf.CertFile, conf.KeyFile, conf.ClientCAFile} {
+		if f != "" {
+			dirs[filepath.Dir(f)] = struct{}{}
+		}
+	}
+
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+
+			return nil, err
+		}
+	}
+
+	r.watcher = watcher
+	go r.watch()
+
+	return r, nil
+}
+
+// TLSConfig returns a server TLS configuration which always uses the latest certificates
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   r.clientAuth,
+				ClientCAs:    r.clientCAs,
+			}, nil
+		},
+	}
+}
+
+// Close stops watching the files
+func (r *Reloader) Close() error {
+	return r.watcher.Close()
+}
+
+func (r *Reloader) watch() {
+	for {
+		select {
+		case e, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if e.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+				continue
+			}
+
+			if err := r.reload(); err != nil {
+				log.Error().Err(err).Msg("tlsconfig: failed to reload certificates, keep using the previous ones")
+
+				continue
+			}
+
+			log.Info().Msg("tlsconfig: reloaded certificates")
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			log.Error().Err(err).Msg("tlsconfig: watcher error")
+		}
+	}
+}
+
+func (r *Reloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.conf.CertFile, r.conf.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	var clientCAs *x509.CertPool
+	if r.conf.ClientCAFile != "" {
+		bb, err := os.ReadFile(r.conf.ClientCAFile)
+		if err != nil {
+			return err
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(bb) {
+			return fmt.Errorf("tlsconfig: no certificate found in %s", r.conf.ClientCAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cert = &cert
+	r.clientCAs = clientCAs
+
+	return nil
+}
@@ -0,0 +1,465 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+
+	"github.com/synycboom/algorand-notification/event"
+	"github.com/synycboom/algorand-notification/metrics"
+)
+
+const (
+	// EventHeader is a header containing an event type
+	EventHeader = "X-Algorand-Notification-Event"
+
+	// TimestampHeader is a header containing a unix timestamp of the delivery attempt
+	TimestampHeader = "X-Algorand-Notification-Timestamp"
+
+	// SignatureHeader is a header containing a hex encoded HMAC-SHA256 of "<timestamp>.<body>"
+	SignatureHeader = "X-Algorand-Notification-Signature"
+)
+
+var (
+	// ErrSubscriptionExists is returned when a subscription id is already used
+	ErrSubscriptionExists = errors.New("webhook: subscription already exists")
+
+	// ErrSubscriptionNotFound is returned when a subscription does not exist
+	ErrSubscriptionNotFound = errors.New("webhook: subscription not found")
+
+	// ErrPrivateTarget is returned when a target is a loopback, link-local or private address that is not allowed
+	ErrPrivateTarget = errors.New("webhook: private targets are not allowed")
+)
+
+// Subscription represents a webhook target
+type Subscription struct {
+	ID      string   `json:"id" mapstructure:"id"`
+	URL     string   `json:"url" mapstructure:"url"`
+	Secret  string   `json:"secret,omitempty" mapstructure:"secret"`
+	Events  []string `json:"events" mapstructure:"events"`
+	Address string   `json:"address,omitempty" mapstructure:"address"`
+
+	// Owner is the subject of the principal that created the subscription with the API, it is empty for the admin
+	Owner string `json:"owner,omitempty" mapstructure:"-"`
+}
+
+// DeadLetter represents an event that could not be delivered
+type DeadLetter struct {
+	SubscriptionID string          `json:"subscriptionId"`
+	EventType      string          `json:"eventType"`
+	Round          uint64          `json:"round"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"lastError"`
+	FailedAt       time.Time       `json:"failedAt"`
+}
+
+// Config represents a dispatcher configuration
+type Config struct {
+	Subscriptions  []Subscription
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Timeout        time.Duration
+	QueueSize      int
+	DeadLetterSize int
+
+	// AllowHTTP allows plain http targets, e.g. for local receivers
+	AllowHTTP bool
+
+	// AllowPrivateTargets allows loopback, link-local and private targets, e.g. for receivers in the same network
+	AllowPrivateTargets bool
+
+	// HTTPClient is used for deliveries, a client with Timeout that only dials allowed targets is created if it is nil
+	HTTPClient *http.Client
+}
+
+// Dispatcher delivers events to webhook subscriptions
+type Dispatcher struct {
+	conf        Config
+	client      *http.Client
+	mu          sync.RWMutex
+	endpoints   map[string]*endpoint
+	deadMu      sync.Mutex
+	deadLetters []DeadLetter
+}
+
+type endpoint struct {
+	sub       Subscription
+	queue     chan *event.Event
+	closeChan chan struct{}
+}
+
+// New creates a new dispatcher
+func New(conf Config) (*Dispatcher, error) {
+	if conf.MaxAttempts <= 0 {
+		return nil, fmt.Errorf("webhook: MaxAttempts must be greater than zero")
+	}
+
+	if conf.InitialBackoff <= 0 || conf.MaxBackoff < conf.InitialBackoff {
+		return nil, fmt.Errorf("webhook: MaxBackoff must be greater than InitialBackoff which must be positive")
+	}
+
+	if conf.QueueSize <= 0 {
+		return nil, fmt.Errorf("webhook: QueueSize must be greater than zero")
+	}
+
+	httpClient := conf.HTTPClient
+	if httpClient == nil {
+		httpClient = newHTTPClient(conf)
+	}
+
+	d := &Dispatcher{
+		conf:      conf,
+		client:    httpClient,
+		endpoints: make(map[string]*endpoint),
+	}
+	for _, sub := range conf.Subscriptions {
+		if err := d.Add(sub); err != nil {
+			d.Close()
+
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// Add adds a subscription and starts delivering events to it
+func (d *Dispatcher) Add(sub Subscription) error {
+	if err := d.validate(sub); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exist := d.endpoints[sub.ID]; exist {
+		return ErrSubscriptionExists
+	}
+
+	ep := &endpoint{
+		sub:       sub,
+		queue:     make(chan *event.Event, d.conf.QueueSize),
+		closeChan: make(chan struct{}),
+	}
+	d.endpoints[sub.ID] = ep
+
+	go d.deliverLoop(ep)
+
+	return nil
+}
+
+// Remove stops delivering events to a subscription
+func (d *Dispatcher) Remove(id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ep, exist := d.endpoints[id]
+	if !exist {
+		return ErrSubscriptionNotFound
+	}
+
+	close(ep.closeChan)
+	delete(d.endpoints, id)
+
+	return nil
+}
+
+// List returns all subscriptions ordered by id
+func (d *Dispatcher) List() []Subscription {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	subs := make([]Subscription, 0, len(d.endpoints))
+	for _, ep := range d.endpoints {
+		subs = append(subs, ep.sub)
+	}
+
+	sort.Slice(subs, func(i, j int) bool {
+		return subs[i].ID < subs[j].ID
+	})
+
+	return subs
+}
+
+// DeadLetters returns events that could not be delivered, the oldest first
+func (d *Dispatcher) DeadLetters() []DeadLetter {
+	d.deadMu.Lock()
+	defer d.deadMu.Unlock()
+
+	return append([]DeadLetter{}, d.deadLetters...)
+}
+
+// Dispatch queues an event for every subscription it matches
+func (d *Dispatcher) Dispatch(e *event.Event) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, ep := range d.endpoints {
+		if !matches(ep.sub, e) {
+			continue
+		}
+
+		select {
+		case ep.queue <- e:
+		default:
+			d.deadLetter(ep.sub, e, 0, errors.New("delivery queue is full"))
+		}
+	}
+}
+
+// Close stops all deliveries
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for id, ep := range d.endpoints {
+		close(ep.closeChan)
+		delete(d.endpoints, id)
+	}
+}
+
+func (d *Dispatcher) validate(sub Subscription) error {
+	if sub.ID == "" {
+		return fmt.Errorf("webhook: subscription id is required")
+	}
+
+	u, err := url.Parse(sub.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("webhook: subscription %s has an invalid url", sub.ID)
+	}
+
+	if u.Scheme != "https" && !(d.conf.AllowHTTP && u.Scheme == "http") {
+		return fmt.Errorf("webhook: subscription %s must use https", sub.ID)
+	}
+
+	// hostnames are checked again by the dialer, once they are resolved
+	if !d.conf.AllowPrivateTargets && isPrivateHost(u.Hostname()) {
+		return fmt.Errorf("webhook: subscription %s targets a private address", sub.ID)
+	}
+
+	if len(sub.Events) == 0 {
+		return fmt.Errorf("webhook: subscription %s requires events", sub.ID)
+	}
+
+	for _, t := range sub.Events {
+		if !containsType(event.AllEvents, t) {
+			return fmt.Errorf("webhook: subscription %s has an invalid event %s", sub.ID, t)
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) deliverLoop(ep *endpoint) {
+	for {
+		select {
+		case <-ep.closeChan:
+			return
+		case e := <-ep.queue:
+			d.deliver(ep, e)
+		}
+	}
+}
+
+// deliver posts an event with exponential backoff until it succeeds or runs out of attempts
+func (d *Dispatcher) deliver(ep *endpoint, e *event.Event) {
+	logger := log.With().Fields(map[string]interface{}{
+		"subscription_id": ep.sub.ID,
+		"event_type":      e.Type,
+	}).Logger()
+
+	backoff := d.conf.InitialBackoff
+	var err error
+	for attempt := 1; attempt <= d.conf.MaxAttempts; attempt++ {
+		if err = d.post(ep.sub, e); err == nil {
+			d.observe(ep.sub.ID, "success")
+
+			return
+		}
+
+		d.observe(ep.sub.ID, "failure")
+		logger.Warn().Err(err).Msgf("webhook: delivery attempt #%d failed", attempt)
+
+		if attempt == d.conf.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-ep.closeChan:
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > d.conf.MaxBackoff {
+			backoff = d.conf.MaxBackoff
+		}
+	}
+
+	d.deadLetter(ep.sub, e, d.conf.MaxAttempts, err)
+}
+
+func (d *Dispatcher) post(sub Subscription, e *event.Event) error {
+	start := time.Now()
+	defer func() {
+		metric, err := metrics.WebhookDeliveryDuration.GetMetricWith(prometheus.Labels{"endpoint": sub.ID})
+		if err == nil {
+			metric.Observe(time.Since(start).Seconds())
+		}
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, sub.URL, bytes.NewReader(e.Payload))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(start.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, e.Type)
+	req.Header.Set(TimestampHeader, timestamp)
+	if sub.Secret != "" {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(sub.Secret, timestamp, e.Payload))
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook: unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) deadLetter(sub Subscription, e *event.Event, attempts int, err error) {
+	d.deadMu.Lock()
+	defer d.deadMu.Unlock()
+
+	d.deadLetters = append(d.deadLetters, DeadLetter{
+		SubscriptionID: sub.ID,
+		EventType:      e.Type,
+		Round:          e.Round,
+		Payload:        e.Payload,
+		Attempts:       attempts,
+		LastError:      err.Error(),
+		FailedAt:       time.Now(),
+	})
+	if len(d.deadLetters) > d.conf.DeadLetterSize {
+		d.deadLetters = d.deadLetters[len(d.deadLetters)-d.conf.DeadLetterSize:]
+	}
+
+	metric, mErr := metrics.WebhookDeadLetters.GetMetricWith(prometheus.Labels{"endpoint": sub.ID})
+	if mErr == nil {
+		metric.Inc()
+	}
+}
+
+func (d *Dispatcher) observe(endpoint, result string) {
+	metric, err := metrics.WebhookDeliveries.GetMetricWith(prometheus.Labels{
+		"endpoint": endpoint,
+		"result":   result,
+	})
+	if err != nil {
+		return
+	}
+
+	metric.Inc()
+}
+
+// newHTTPClient creates a client that refuses to connect to private addresses unless they are allowed.
+// Targets are dialed directly, so that the checked address is the one of the target and not of a proxy.
+func newHTTPClient(conf Config) *http.Client {
+	dialer := &net.Dialer{Timeout: conf.Timeout}
+	if !conf.AllowPrivateTargets {
+		dialer.Control = dialControl
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   conf.Timeout,
+		Transport: transport,
+	}
+}
+
+// dialControl rejects connections to private addresses, it sees the resolved address of every connection and redirect
+func dialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || isPrivateIP(ip) {
+		return ErrPrivateTarget
+	}
+
+	return nil
+}
+
+// isPrivateHost returns true if a host is a private address or a name of the local host
+func isPrivateHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && isPrivateIP(ip)
+}
+
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsPrivate() || ip.IsUnspecified()
+}
+
+// Sign returns a hex encoded HMAC-SHA256 signature of "<timestamp>.<body>"
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func matches(sub Subscription, e *event.Event) bool {
+	if !containsType(sub.Events, e.Type) {
+		return false
+	}
+
+	return sub.Address == "" || e.HasAddress(sub.Address)
+}
+
+func containsType(types []string, t string) bool {
+	for _, v := range types {
+		if v == t {
+			return true
+		}
+	}
+
+	return false
+}
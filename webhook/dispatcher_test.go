@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/synycboom/algorand-notification/event"
+)
+
+// delivery is a request received by a test receiver
+type delivery struct {
+	eventType string
+	timestamp string
+	signature string
+	body      []byte
+}
+
+// receiver is a webhook endpoint failing the first requests
+type receiver struct {
+	mu         sync.Mutex
+	failures   int
+	deliveries []delivery
+	received   chan struct{}
+}
+
+func newReceiver(failures int) *receiver {
+	return &receiver{
+		failures: failures,
+		received: make(chan struct{}, 16),
+	}
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	r.deliveries = append(r.deliveries, delivery{
+		eventType: req.Header.Get(EventHeader),
+		timestamp: req.Header.Get(TimestampHeader),
+		signature: req.Header.Get(SignatureHeader),
+		body:      body,
+	})
+	fail := len(r.deliveries) <= r.failures
+	r.mu.Unlock()
+
+	if fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	r.received <- struct{}{}
+}
+
+func (r *receiver) wait(t *testing.T, n int) []delivery {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		select {
+		case <-r.received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d deliveries, want %d", i, n)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]delivery{}, r.deliveries...)
+}
+
+func newTestDispatcher(t *testing.T, maxAttempts int) *Dispatcher {
+	t.Helper()
+
+	d, err := New(Config{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+		Timeout:        time.Second,
+		QueueSize:      10,
+		DeadLetterSize: 10,
+		AllowHTTP:      true,
+		// httptest servers listen on the loopback address
+		AllowPrivateTargets: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create a dispatcher: %v", err)
+	}
+	t.Cleanup(d.Close)
+
+	return d
+}
+
+func TestDeliverSignsAndRetries(t *testing.T) {
+	r := newReceiver(2)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	const secret = "my-secret"
+	d := newTestDispatcher(t, 3)
+	if err := d.Add(Subscription{ID: "payments", URL: srv.URL, Secret: secret, Events: []string{event.NewPaymentTx}}); err != nil {
+		t.Fatalf("failed to add a subscription: %v", err)
+	}
+
+	payload := []byte(`{"eventType":"NEW_PAYMENT_TX","data":{"id":"TX"}}`)
+	d.Dispatch(&event.Event{Type: event.NewBlock, Payload: []byte(`{"eventType":"NEW_BLOCK"}`)})
+	d.Dispatch(&event.Event{Type: event.NewPaymentTx, Payload: payload})
+
+	deliveries := r.wait(t, 3)
+	if len(deliveries) != 3 {
+		t.Fatalf("got %d deliveries, want 3", len(deliveries))
+	}
+
+	for i, got := range deliveries {
+		if got.eventType != event.NewPaymentTx {
+			t.Errorf("delivery %d has the event type %q", i, got.eventType)
+		}
+
+		if string(got.body) != string(payload) {
+			t.Errorf("delivery %d has the body %s", i, got.body)
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(got.timestamp + "." + string(got.body)))
+		if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); got.signature != want {
+			t.Errorf("delivery %d has the signature %q, want %q", i, got.signature, want)
+		}
+	}
+
+	if deadLetters := d.DeadLetters(); len(deadLetters) != 0 {
+		t.Fatalf("got %d dead letters of a delivered event", len(deadLetters))
+	}
+}
+
+func TestDeliverDeadLetter(t *testing.T) {
+	r := newReceiver(100)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	d := newTestDispatcher(t, 2)
+	if err := d.Add(Subscription{ID: "blocks", URL: srv.URL, Events: []string{event.NewBlock}}); err != nil {
+		t.Fatalf("failed to add a subscription: %v", err)
+	}
+
+	d.Dispatch(&event.Event{Type: event.NewBlock, Round: 7, Payload: []byte(`{"eventType":"NEW_BLOCK"}`)})
+
+	if deliveries := r.wait(t, 2); deliveries[0].signature != "" {
+		t.Errorf("a delivery without a secret has the signature %q", deliveries[0].signature)
+	}
+
+	// the dead letter is added after the last response is read
+	deadline := time.Now().Add(5 * time.Second)
+	for len(d.DeadLetters()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	deadLetters := d.DeadLetters()
+	if len(deadLetters) != 1 {
+		t.Fatalf("got %d dead letters, want 1", len(deadLetters))
+	}
+
+	if dl := deadLetters[0]; dl.SubscriptionID != "blocks" || dl.Round != 7 || dl.Attempts != 2 {
+		t.Fatalf("unexpected dead letter %+v", dl)
+	}
+}
+
+func TestPrivateTargets(t *testing.T) {
+	d, err := New(Config{
+		MaxAttempts:    1,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Timeout:        time.Second,
+		QueueSize:      1,
+		AllowHTTP:      true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create a dispatcher: %v", err)
+	}
+	defer d.Close()
+
+	rejected := []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://api.localhost/hook",
+		"http://[::1]/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.1/hook",
+		"http://172.16.0.1/hook",
+		"http://192.168.1.1/hook",
+		"http://[fd00::1]/hook",
+		"http://0.0.0.0/hook",
+	}
+	for _, u := range rejected {
+		if err := d.Add(Subscription{ID: u, URL: u, Events: []string{event.NewBlock}}); err == nil {
+			t.Errorf("%s was added", u)
+		}
+	}
+
+	if err := d.Add(Subscription{ID: "public", URL: "https://example.com/hook", Events: []string{event.NewBlock}}); err != nil {
+		t.Errorf("a public target was rejected: %v", err)
+	}
+
+	// host names are checked once they are resolved
+	for _, address := range []string{"127.0.0.1:443", "[::1]:443", "10.1.2.3:443", "169.254.169.254:80"} {
+		if err := dialControl("tcp", address, nil); err != ErrPrivateTarget {
+			t.Errorf("dialing %s returned %v", address, err)
+		}
+	}
+
+	if err := dialControl("tcp", "93.184.216.34:443", nil); err != nil {
+		t.Errorf("dialing a public address returned %v", err)
+	}
+
+	// connections of a dispatcher without a custom client are checked
+	srv := httptest.NewServer(newReceiver(0))
+	defer srv.Close()
+
+	if _, err := d.client.Get(srv.URL); !errors.Is(err, ErrPrivateTarget) {
+		t.Fatalf("dialing a private target returned %v", err)
+	}
+}